@@ -0,0 +1,40 @@
+// Command benchreport reads the output of the BenchmarkOperations benchmark from the files
+// given as arguments, or from standard input, and prints a markdown table comparing BigNumber with math/big.
+//
+// Usage:
+//
+//	go test ./tests -run '^$' -bench Operations -benchmem | go run ./cmd/benchreport
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/danielost/big-numbers/src/benchreport"
+)
+
+func main() {
+	readers := []io.Reader{os.Stdin}
+	if len(os.Args) > 1 {
+		readers = readers[:0]
+		for _, name := range os.Args[1:] {
+			f, err := os.Open(name)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "benchreport: %v\n", err)
+				os.Exit(1)
+			}
+			defer f.Close()
+			readers = append(readers, f)
+		}
+	}
+	rows, err := benchreport.Parse(io.MultiReader(readers...))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "benchreport: %v\n", err)
+		os.Exit(1)
+	}
+	if err := benchreport.WriteMarkdown(os.Stdout, rows); err != nil {
+		fmt.Fprintf(os.Stderr, "benchreport: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,18 @@
+// Command bn is a command-line calculator for big numbers.
+//
+// Usage:
+//
+//	bn [--out hex|dec|bin|oct] <command> [operands...]
+//
+// Run "bn help" for the list of commands.
+package main
+
+import (
+	"os"
+
+	"github.com/danielost/big-numbers/src/cli"
+)
+
+func main() {
+	os.Exit(cli.Run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
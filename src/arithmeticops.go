@@ -11,3 +11,11 @@ type AdvancedArithmeticOps interface {
 	DIV(BigNumber) BigNumber
 	POWMOD(BigNumber, uint64) BigNumber
 }
+
+type ScalarOps interface {
+	AddUint64(uint64) BigNumber
+	SubUint64(uint64) (BigNumber, error)
+	MulUint64(uint64) BigNumber
+	DivModUint64(uint64) (BigNumber, uint64)
+	CmpUint64(uint64) int
+}
@@ -0,0 +1,168 @@
+// Package benchreport turns the output of BenchmarkOperations into a markdown table
+// comparing BigNumber with math/big.
+package benchreport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// benchmarkPrefix is the name of the benchmark whose sub-benchmarks are reported.
+const benchmarkPrefix = "BenchmarkOperations/"
+
+// Measurement holds the averaged results of one sub-benchmark.
+type Measurement struct {
+	NsPerOp     float64
+	AllocsPerOp float64
+	runs        int
+}
+
+// Row compares BigNumber and math/big for one operation and operand size.
+type Row struct {
+	Operation string
+	Bits      int
+	BigNumber *Measurement
+	MathBig   *Measurement
+}
+
+// Parse reads `go test -bench` output and returns one row per operation and size,
+// ordered by the first appearance of the operation and then by size.
+// Repeated runs (-count) are averaged.
+func Parse(r io.Reader) ([]Row, error) {
+	rows := make(map[string]*Row)
+	order := make([]string, 0)
+	operations := make(map[string]int)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || !strings.HasPrefix(fields[0], benchmarkPrefix) {
+			continue
+		}
+		parts := strings.Split(trimProcs(strings.TrimPrefix(fields[0], benchmarkPrefix)), "/")
+		if len(parts) != 3 || !strings.HasPrefix(parts[1], "bits=") {
+			return nil, fmt.Errorf("unexpected benchmark name %q", fields[0])
+		}
+		bits, err := strconv.Atoi(strings.TrimPrefix(parts[1], "bits="))
+		if err != nil {
+			return nil, fmt.Errorf("unexpected benchmark name %q: %w", fields[0], err)
+		}
+		key := parts[0] + "/" + parts[1]
+		row, ok := rows[key]
+		if !ok {
+			row = &Row{Operation: parts[0], Bits: bits}
+			rows[key] = row
+			order = append(order, key)
+			if _, seen := operations[parts[0]]; !seen {
+				operations[parts[0]] = len(operations)
+			}
+		}
+		var measurement **Measurement
+		switch parts[2] {
+		case "BigNumber":
+			measurement = &row.BigNumber
+		case "big":
+			measurement = &row.MathBig
+		default:
+			return nil, fmt.Errorf("unexpected implementation %q in %q", parts[2], fields[0])
+		}
+		if *measurement == nil {
+			*measurement = &Measurement{}
+		}
+		if err := (*measurement).add(fields[2:]); err != nil {
+			return nil, fmt.Errorf("%s: %w", fields[0], err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make([]Row, len(order))
+	for i, key := range order {
+		result[i] = *rows[key]
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Operation != result[j].Operation {
+			return operations[result[i].Operation] < operations[result[j].Operation]
+		}
+		return result[i].Bits < result[j].Bits
+	})
+	return result, nil
+}
+
+// trimProcs removes the -GOMAXPROCS suffix from a benchmark name.
+func trimProcs(name string) string {
+	if i := strings.LastIndex(name, "-"); i >= 0 {
+		if _, err := strconv.Atoi(name[i+1:]); err == nil {
+			return name[:i]
+		}
+	}
+	return name
+}
+
+// add folds the value/unit pairs of one benchmark line into the running average.
+func (m *Measurement) add(pairs []string) error {
+	var ns, allocs float64
+	for i := 0; i+1 < len(pairs); i += 2 {
+		value, err := strconv.ParseFloat(pairs[i], 64)
+		if err != nil {
+			return fmt.Errorf("invalid value %q", pairs[i])
+		}
+		switch pairs[i+1] {
+		case "ns/op":
+			ns = value
+		case "allocs/op":
+			allocs = value
+		}
+	}
+	m.NsPerOp = (m.NsPerOp*float64(m.runs) + ns) / float64(m.runs+1)
+	m.AllocsPerOp = (m.AllocsPerOp*float64(m.runs) + allocs) / float64(m.runs+1)
+	m.runs++
+	return nil
+}
+
+// WriteMarkdown writes the rows as a markdown table. Ratios are BigNumber divided by math/big,
+// so values above 1 mean BigNumber is slower or allocates more.
+func WriteMarkdown(w io.Writer, rows []Row) error {
+	var sb strings.Builder
+	sb.WriteString("| Operation | Bits | BigNumber ns/op | math/big ns/op | ns/op ratio | BigNumber allocs/op | math/big allocs/op | allocs/op ratio |\n")
+	sb.WriteString("|---|---:|---:|---:|---:|---:|---:|---:|\n")
+	for _, row := range rows {
+		fmt.Fprintf(&sb, "| %s | %d | %s | %s | %s | %s | %s | %s |\n",
+			row.Operation, row.Bits,
+			formatValue(row.BigNumber, func(m *Measurement) float64 { return m.NsPerOp }),
+			formatValue(row.MathBig, func(m *Measurement) float64 { return m.NsPerOp }),
+			formatRatio(row.BigNumber, row.MathBig, func(m *Measurement) float64 { return m.NsPerOp }),
+			formatValue(row.BigNumber, func(m *Measurement) float64 { return m.AllocsPerOp }),
+			formatValue(row.MathBig, func(m *Measurement) float64 { return m.AllocsPerOp }),
+			formatRatio(row.BigNumber, row.MathBig, func(m *Measurement) float64 { return m.AllocsPerOp }),
+		)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func formatValue(m *Measurement, value func(*Measurement) float64) string {
+	if m == nil {
+		return "n/a"
+	}
+	return strconv.FormatFloat(value(m), 'f', -1, 64)
+}
+
+func formatRatio(a, b *Measurement, value func(*Measurement) float64) string {
+	if a == nil || b == nil {
+		return "n/a"
+	}
+	switch numerator, denominator := value(a), value(b); {
+	case denominator != 0:
+		return strconv.FormatFloat(numerator/denominator, 'f', 2, 64) + "x"
+	case numerator == 0:
+		return "1.00x"
+	default:
+		return "∞"
+	}
+}
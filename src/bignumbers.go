@@ -6,59 +6,93 @@ import (
 )
 
 // BigNumber represents a big number as a slice of blocks. Each block is a uint64 value.
+// The blocks are stored least significant first.
+//
+// A BigNumber is kept in canonical form: the most significant block is never zero,
+// so zero is represented by an empty slice of blocks. Every constructor and operation
+// returns canonical values, and SetBlocks drops leading zero blocks from its input.
+// The only exception is AppendBlock, see its documentation.
+//
+// Operations such as ADD, XOR or Cmp only read their receiver and operands and return
+// values that share no blocks with them, so a BigNumber that is no longer modified may be
+// used by any number of goroutines at once. Methods that modify the receiver (the Set* methods,
+// AppendBlock, SetBit and friends, the Unmarshal and Scan methods and the in-place API) must not
+// run concurrently with any other use of the same BigNumber.
+//
+// Assigning a BigNumber copies only the slice header, so the copy shares its blocks with the
+// original. Use Clone to obtain an independent copy before modifying it in place.
 type BigNumber struct {
 	blocks []Uint
 }
 
-// GetBlocks returns the blocks of the BigNumber.
+// GetBlocks returns a copy of the blocks of the BigNumber.
 func (bn *BigNumber) GetBlocks() []Uint {
-	return bn.blocks
+	if len(bn.blocks) == 0 {
+		return nil
+	}
+	return append([]Uint(nil), bn.blocks...)
 }
 
-// SetBlocks sets the blocks of the BigNumber to the provided slice of Uint blocks.
+// SetBlocks sets the blocks of the BigNumber to a copy of the provided slice of Uint blocks, least significant first.
+// Leading zero blocks are dropped to keep the BigNumber canonical.
 func (bn *BigNumber) SetBlocks(blocks []Uint) {
-	bn.blocks = blocks
+	bn.setBlocks(append([]Uint(nil), norm(blocks)...))
+}
+
+// setBlocks is SetBlocks without the copy: the BigNumber takes ownership of blocks.
+func (bn *BigNumber) setBlocks(blocks []Uint) {
+	bn.blocks = norm(blocks)
 }
 
+// Clone returns a copy of the BigNumber that shares no blocks with it.
+func (bn *BigNumber) Clone() (result BigNumber) {
+	result.SetBlocks(bn.blocks)
+	return
+}
+
+// AppendBlock appends a new most significant block to the BigNumber.
+// Appending a zero block leaves the BigNumber non-canonical until a non-zero block is appended after it.
 func (bn *BigNumber) AppendBlock(block Uint) {
-	bn.SetBlocks(append(bn.GetBlocks(), block))
+	bn.blocks = append(bn.blocks, block)
 }
 
 // SetHex sets the value of the BigNumber using a hexadecimal string.
+// An optional 0x prefix and '_' digit separators are allowed.
 func (bn *BigNumber) SetHex(hex string) error {
-	return bn.setValue(16, hex, func(s string) (Uint, error) {
-		var u Uint
-		if err := u.SetHex(s); err != nil {
-			return Uint{0}, err
-		}
-		return u, nil
+	hex, err := parseDigits(hex, 16, "0x", 0)
+	if err != nil {
+		return err
+	}
+	bn.setValue(16, hex, func(s string) (u Uint) {
+		u.setHexDigits(s)
+		return
 	})
+	return nil
 }
 
 // SetBinary sets the value of the BigNumber using a binary string.
+// An optional 0b prefix and '_' digit separators are allowed.
 func (bn *BigNumber) SetBinary(binary string) error {
-	return bn.setValue(64, binary, func(s string) (Uint, error) {
-		var u Uint
-		if err := u.SetBinary(s); err != nil {
-			return Uint{0}, err
-		}
-		return u, nil
+	binary, err := parseDigits(binary, 2, "0b", 0)
+	if err != nil {
+		return err
+	}
+	bn.setValue(64, binary, func(s string) (u Uint) {
+		u.setBinaryDigits(s)
+		return
 	})
+	return nil
 }
 
 // setValue sets the value of the BigNumber based on the provided block size, value, and setter function.
-func (bn *BigNumber) setValue(blockSize int, value string, setter func(string) (Uint, error)) error {
+// The value must contain only validated digits.
+func (bn *BigNumber) setValue(blockSize int, value string, setter func(string) Uint) {
 	inputBlocks := breakStringIntoBlocks(value, blockSize)
-	resultBlocks := make([]Uint, 0)
+	resultBlocks := make([]Uint, 0, len(inputBlocks))
 	for _, block := range inputBlocks {
-		u, err := setter(block)
-		if err != nil {
-			return err
-		}
-		resultBlocks = append(resultBlocks, u)
+		resultBlocks = append(resultBlocks, setter(block))
 	}
-	bn.SetBlocks(resultBlocks)
-	return nil
+	bn.setBlocks(resultBlocks)
 }
 
 // GetHex returns the hexadecimal representation of the BigNumber.
@@ -77,9 +111,9 @@ func (bn *BigNumber) GetBinary() (hex string) {
 
 // getValue returns the representation of the BigNumber based on the provided block size and getter function.
 func (bn *BigNumber) getValue(blockSize int, getter func(Uint) string) (result string) {
-	for i, block := range bn.GetBlocks() {
+	for i, block := range bn.blocks {
 		blockValue := getter(block)
-		if i != len(bn.GetBlocks())-1 {
+		if i != len(bn.blocks)-1 {
 			blockValue = AddLeadingZeros(blockValue, blockSize)
 		}
 		result = blockValue + result
@@ -89,28 +123,28 @@ func (bn *BigNumber) getValue(blockSize int, getter func(Uint) string) (result s
 
 // clearLeadingZeros removes leading zero blocks from the BigNumber.
 func (bn *BigNumber) clearLeadingZeros() {
-	for len(bn.GetBlocks()) > 0 && bn.GetBlocks()[len(bn.GetBlocks())-1].GetDecimal() == 0 {
-		bn.SetBlocks(bn.GetBlocks()[:len(bn.GetBlocks())-1])
+	bn.blocks = norm(bn.blocks)
+}
+
+// norm removes leading zero blocks without reallocating.
+func norm(blocks []Uint) []Uint {
+	for len(blocks) > 0 && blocks[len(blocks)-1].GetDecimal() == 0 {
+		blocks = blocks[:len(blocks)-1]
 	}
+	return blocks
 }
 
 // Invert returns the bitwise inversion of the BigNumber.
-func (bn *BigNumber) Invert() (result BigNumber) {
-	invertedBlocks := make([]Uint, len(bn.GetBlocks()))
-	for i, block := range bn.GetBlocks() {
-		invertedBlocks[i] = block.Invert()
-	}
-	result.SetBlocks(invertedBlocks)
-	resultHex := result.GetHex()
-	resultHex = resultHex[len(resultHex)-len(bn.GetHex()):]
-	result.SetHex(resultHex)
-	return
+// The result is truncated to the number of hex digits of the BigNumber, so the unused bits of the top nibble
+// are inverted as well. Use InvertWidth for an inversion over an explicit number of bits.
+func (bn *BigNumber) Invert() BigNumber {
+	return bn.InvertWidth((bn.BitLen() + 3) / 4 * 4)
 }
 
 // binaryOperation performs a binary operation on two BigNumbers using the provided operation function.
 func binaryOperation(a, b BigNumber, operation func(Uint, Uint) Uint) (result BigNumber) {
-	aBlocks := a.GetBlocks()
-	bBlocks := b.GetBlocks()
+	aBlocks := a.blocks
+	bBlocks := b.blocks
 	for i := 0; i < len(aBlocks) || i < len(bBlocks); i++ {
 		if i >= len(aBlocks) {
 			result.AppendBlock(operation(bBlocks[i], Uint{0}))
@@ -154,101 +188,60 @@ func (bn *BigNumber) ShiftL(n int) (result BigNumber) {
 // ShiftR performs a right shift operation on the BigNumber.
 func (bn *BigNumber) ShiftR(n int) (result BigNumber) {
 	binary := bn.GetBinary()
+	if n >= len(binary) {
+		return
+	}
 	result.SetBinary(binary[:len(binary)-n])
 	return
 }
 
 // LessThan checks if the BigNumber is less than another BigNumber.
 func (bn *BigNumber) LessThan(other BigNumber) bool {
-	thisBlocks := bn.GetBlocks()
-	otherBlocks := other.GetBlocks()
-	if len(thisBlocks) < len(otherBlocks) {
-		return true
-	}
-	if len(thisBlocks) > len(otherBlocks) {
-		return false
-	}
-	for i := len(thisBlocks) - 1; i >= 0; i-- {
-		if thisBlocks[i].GetDecimal() < otherBlocks[i].GetDecimal() {
-			return true
-		}
-		if thisBlocks[i].GetDecimal() > otherBlocks[i].GetDecimal() {
-			return false
-		}
-	}
-	return false
+	return bn.Cmp(other) < 0
 }
 
 // ADD performs addition of two BigNumbers.
 func (bn *BigNumber) ADD(other BigNumber) (result BigNumber) {
-	carry := Uint{0}
-	thisBlocks := bn.GetBlocks()
-	otherBlocks := other.GetBlocks()
-	for i := 0; i < len(thisBlocks) || i < len(otherBlocks); i++ {
-		if i >= len(thisBlocks) {
-			result.AppendBlock(otherBlocks[i].ADD(carry))
-			carry = Uint{0}
-		} else if i >= len(otherBlocks) {
-			result.AppendBlock(thisBlocks[i].ADD(carry))
-			carry = Uint{0}
-		} else {
-			sum := thisBlocks[i].ADD(otherBlocks[i])
-			sum = sum.ADD(carry)
-			result.AppendBlock(sum)
-			if sum.GetDecimal() < thisBlocks[i].GetDecimal() || sum.GetDecimal() < otherBlocks[i].GetDecimal() {
-				carry = Uint{1}
-			} else {
-				carry = Uint{0}
-			}
-		}
-	}
-	if carry.GetDecimal() > 0 {
-		result.AppendBlock(carry)
+	thisBlocks := bn.blocks
+	otherBlocks := other.blocks
+	n := max(len(thisBlocks), len(otherBlocks))
+	blocks := make([]Uint, n+1)
+	carry := uint64(0)
+	for i := 0; i < n; i++ {
+		left := blockAt(thisBlocks, i)
+		blocks[i], carry = left.AddCarry(blockAt(otherBlocks, i), carry)
 	}
+	blocks[n] = Uint{carry}
+	result.setBlocks(blocks)
 	return
 }
 
 // SUB performs subtraction of two BigNumbers.
 func (bn *BigNumber) SUB(other BigNumber) (result BigNumber, err error) {
 	if bn.LessThan(other) {
-		return BigNumber{}, fmt.Errorf("sub result is negative")
-	}
-	carry := Uint{0}
-	thisBlocks := bn.GetBlocks()
-	otherBlocks := other.GetBlocks()
-	for i := 0; i < len(thisBlocks) || i < len(otherBlocks); i++ {
-		if i >= len(thisBlocks) {
-			result.AppendBlock(otherBlocks[i].SUB(carry))
-			carry = Uint{0}
-		} else if i >= len(otherBlocks) {
-			result.AppendBlock(thisBlocks[i].SUB(carry))
-			carry = Uint{0}
-		} else {
-			diff := thisBlocks[i].SUB(otherBlocks[i])
-			diff = diff.SUB(carry)
-			result.AppendBlock(diff)
-			if diff.GetDecimal() > thisBlocks[i].GetDecimal() {
-				carry = Uint{1}
-			} else {
-				carry = Uint{0}
-			}
-		}
+		return BigNumber{}, fmt.Errorf("sub %w", ErrNegativeResult)
 	}
-	if carry.GetDecimal() > 0 {
-		result.AppendBlock(carry)
+	thisBlocks := bn.blocks
+	otherBlocks := other.blocks
+	blocks := make([]Uint, len(thisBlocks))
+	borrow := uint64(0)
+	for i := range thisBlocks {
+		blocks[i], borrow = thisBlocks[i].SubBorrow(blockAt(otherBlocks, i), borrow)
 	}
-	result.clearLeadingZeros()
+	result.setBlocks(blocks)
 	return
 }
 
-/*
-MOD calculates the modulo of two BigNumbers.
-Note: this implementation is very inefficient as it doesn't use the division operation.
-*/
-func (bn *BigNumber) MOD(other BigNumber) (result BigNumber) {
-	result.SetBlocks(bn.GetBlocks())
-	for !result.LessThan(other) {
-		result, _ = result.SUB(other)
+// blockAt returns the i-th block, or zero if there are not enough blocks.
+func blockAt(blocks []Uint, i int) Uint {
+	if i < len(blocks) {
+		return blocks[i]
 	}
-	return
+	return Uint{0}
+}
+
+// MOD calculates the modulo of two BigNumbers. It panics with ErrDivisionByZero if other is zero.
+func (bn *BigNumber) MOD(other BigNumber) BigNumber {
+	_, remainder := bn.DivMod(other)
+	return remainder
 }
@@ -8,3 +8,23 @@ type BinaryOps interface {
 	ShiftR(int) BigNumber
 	ShiftL(int) BigNumber
 }
+
+type BitOps interface {
+	Bit(int) uint
+	SetBit(int, uint)
+	ClearBit(int)
+	FlipBit(int)
+	BitLen() int
+	TrailingZeros() int
+	LeadingZeros(int) int
+	PopCount() int
+	Extract(int, int) BigNumber
+}
+
+type FixedWidthOps interface {
+	Truncate(int) BigNumber
+	InvertWidth(int) BigNumber
+	RotL(int, int) BigNumber
+	RotR(int, int) BigNumber
+	ShiftLWidth(int, int) BigNumber
+}
@@ -0,0 +1,229 @@
+package bignumbers
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// blockBits is the number of bits in a single block.
+const blockBits = 64
+
+// checkBitIndex panics if the bit index is negative.
+func checkBitIndex(i int) {
+	if i < 0 {
+		panic(fmt.Sprintf("bignumbers: negative bit index %d", i))
+	}
+}
+
+// Bit returns the value of the i-th bit of the BigNumber, where bit 0 is the least significant one.
+func (bn *BigNumber) Bit(i int) uint {
+	checkBitIndex(i)
+	blocks := bn.blocks
+	if i/blockBits >= len(blocks) {
+		return 0
+	}
+	return uint(blocks[i/blockBits].GetDecimal()>>(i%blockBits)) & 1
+}
+
+// SetBit sets the i-th bit of the BigNumber to v. Any non-zero v sets the bit to one.
+func (bn *BigNumber) SetBit(i int, v uint) {
+	if v == 0 {
+		bn.ClearBit(i)
+		return
+	}
+	bn.updateBlock(i, func(block, mask uint64) uint64 { return block | mask })
+}
+
+// ClearBit sets the i-th bit of the BigNumber to zero.
+func (bn *BigNumber) ClearBit(i int) {
+	bn.updateBlock(i, func(block, mask uint64) uint64 { return block &^ mask })
+}
+
+// FlipBit inverts the i-th bit of the BigNumber.
+func (bn *BigNumber) FlipBit(i int) {
+	bn.updateBlock(i, func(block, mask uint64) uint64 { return block ^ mask })
+}
+
+// updateBlock replaces the block holding the i-th bit using the provided update function.
+// The blocks are copied first, so values sharing the blocks are not affected.
+func (bn *BigNumber) updateBlock(i int, update func(block, mask uint64) uint64) {
+	checkBitIndex(i)
+	index := i / blockBits
+	length := len(bn.blocks)
+	if index >= length {
+		length = index + 1
+	}
+	blocks := make([]Uint, length)
+	copy(blocks, bn.blocks)
+	blocks[index] = Uint{update(blocks[index].GetDecimal(), 1<<(i%blockBits))}
+	bn.setBlocks(blocks)
+}
+
+// BitLen returns the number of bits required to represent the BigNumber. The bit length of zero is 0.
+func (bn *BigNumber) BitLen() int {
+	blocks := bn.blocks
+	for i := len(blocks) - 1; i >= 0; i-- {
+		if block := blocks[i].GetDecimal(); block != 0 {
+			return i*blockBits + bits.Len64(block)
+		}
+	}
+	return 0
+}
+
+// TrailingZeros returns the number of consecutive least significant zero bits. It returns 0 for zero.
+func (bn *BigNumber) TrailingZeros() int {
+	for i, block := range bn.blocks {
+		if block.GetDecimal() != 0 {
+			return i*blockBits + bits.TrailingZeros64(block.GetDecimal())
+		}
+	}
+	return 0
+}
+
+// LeadingZeros returns the number of leading zero bits when the BigNumber is represented with the given width.
+// The result is negative if the BigNumber does not fit into width bits.
+func (bn *BigNumber) LeadingZeros(width int) int {
+	return width - bn.BitLen()
+}
+
+// PopCount returns the number of one bits in the BigNumber.
+func (bn *BigNumber) PopCount() (count int) {
+	for _, block := range bn.blocks {
+		count += bits.OnesCount64(block.GetDecimal())
+	}
+	return
+}
+
+// Extract returns the bits in the range [lo, hi) of the BigNumber, shifted down to bit 0.
+func (bn *BigNumber) Extract(lo, hi int) (result BigNumber) {
+	checkBitIndex(lo)
+	if hi < lo {
+		panic(fmt.Sprintf("bignumbers: invalid bit range [%d, %d)", lo, hi))
+	}
+	if bitLen := bn.BitLen(); hi > bitLen {
+		hi = bitLen
+	}
+	if lo >= hi {
+		return
+	}
+	blocks := bn.blocks
+	width := hi - lo
+	resultBlocks := make([]Uint, (width+blockBits-1)/blockBits)
+	shift := uint(lo % blockBits)
+	for i := range resultBlocks {
+		index := lo/blockBits + i
+		value := blocks[index].GetDecimal() >> shift
+		if shift > 0 && index+1 < len(blocks) {
+			value |= blocks[index+1].GetDecimal() << (blockBits - shift)
+		}
+		resultBlocks[i] = Uint{value}
+	}
+	if rem := width % blockBits; rem != 0 {
+		last := len(resultBlocks) - 1
+		resultBlocks[last] = Uint{resultBlocks[last].GetDecimal() & (1<<rem - 1)}
+	}
+	result.setBlocks(resultBlocks)
+	return
+}
+
+// checkWidth panics if the width is negative.
+func checkWidth(width int) {
+	if width < 0 {
+		panic(fmt.Sprintf("bignumbers: negative width %d", width))
+	}
+}
+
+// Truncate returns the width least significant bits of the BigNumber, i.e. the BigNumber modulo 2^width.
+func (bn *BigNumber) Truncate(width int) BigNumber {
+	checkWidth(width)
+	return bn.Extract(0, width)
+}
+
+// InvertWidth returns the bitwise inversion of the BigNumber treated as a width-bit unsigned integer.
+// Bits above width are discarded before the inversion, so the result always fits into width bits.
+func (bn *BigNumber) InvertWidth(width int) (result BigNumber) {
+	checkWidth(width)
+	blocks := bn.blocks
+	resultBlocks := make([]Uint, (width+blockBits-1)/blockBits)
+	for i := range resultBlocks {
+		if i < len(blocks) {
+			resultBlocks[i] = blocks[i].Invert()
+		} else {
+			resultBlocks[i] = Uint{^uint64(0)}
+		}
+	}
+	result.setBlocks(resultBlocks)
+	return result.Truncate(width)
+}
+
+// ShiftLWidth performs a left shift of the BigNumber by n bits, discarding the bits shifted past width.
+func (bn *BigNumber) ShiftLWidth(n, width int) BigNumber {
+	checkBitIndex(n)
+	truncated := bn.Truncate(width)
+	if n >= width {
+		return BigNumber{}
+	}
+	shifted := BigNumber{blocks: shiftLeftBlocks(truncated.blocks, n)}
+	return shifted.Truncate(width)
+}
+
+// RotL rotates the BigNumber treated as a width-bit unsigned integer to the left by n bits.
+// Bits above width are discarded before the rotation.
+func (bn *BigNumber) RotL(n, width int) BigNumber {
+	checkBitIndex(n)
+	truncated := bn.Truncate(width)
+	if width == 0 {
+		return truncated
+	}
+	n %= width
+	if n == 0 {
+		return truncated
+	}
+	high := BigNumber{blocks: shiftLeftBlocks(truncated.blocks, n)}
+	high = high.Truncate(width)
+	low := BigNumber{blocks: shiftRightBlocks(truncated.blocks, width-n)}
+	return high.OR(low)
+}
+
+// RotR rotates the BigNumber treated as a width-bit unsigned integer to the right by n bits.
+// Bits above width are discarded before the rotation.
+func (bn *BigNumber) RotR(n, width int) BigNumber {
+	checkBitIndex(n)
+	if width == 0 {
+		return bn.Truncate(width)
+	}
+	return bn.RotL(width-n%width, width)
+}
+
+// shiftLeftBlocks returns a new slice holding blocks shifted to the left by n bits.
+func shiftLeftBlocks(blocks []Uint, n int) []Uint {
+	if len(blocks) == 0 {
+		return []Uint{}
+	}
+	blockShift, bitShift := n/blockBits, uint(n%blockBits)
+	result := make([]Uint, len(blocks)+blockShift+1)
+	spill := Uint{0}
+	for i, block := range blocks {
+		shifted, carry := block.ShiftL(bitShift)
+		result[i+blockShift] = shifted.OR(spill)
+		spill = carry
+	}
+	result[len(blocks)+blockShift] = spill
+	return norm(result)
+}
+
+// shiftRightBlocks returns a new slice holding blocks shifted to the right by n bits.
+func shiftRightBlocks(blocks []Uint, n int) []Uint {
+	blockShift, bitShift := n/blockBits, uint(n%blockBits)
+	if blockShift >= len(blocks) {
+		return []Uint{}
+	}
+	result := make([]Uint, len(blocks)-blockShift)
+	spill := Uint{0}
+	for i := len(result) - 1; i >= 0; i-- {
+		shifted, carry := blocks[i+blockShift].ShiftR(bitShift)
+		result[i] = shifted.OR(spill)
+		spill = carry
+	}
+	return norm(result)
+}
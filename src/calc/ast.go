@@ -0,0 +1,43 @@
+package calc
+
+import (
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// Node is a node of the expression syntax tree.
+type Node interface {
+	// Pos returns the byte offset of the node in the source expression.
+	Pos() int
+}
+
+// Number is a literal operand.
+type Number struct {
+	Value  bignumbers.BigNumber
+	Offset int
+}
+
+// Variable is a reference to a named value.
+type Variable struct {
+	Name   string
+	Offset int
+}
+
+// Unary is a prefix operation applied to a single operand.
+type Unary struct {
+	Op      string
+	Operand Node
+	Offset  int
+}
+
+// Binary is an infix operation applied to two operands. Offset points at the operator.
+type Binary struct {
+	Op     string
+	Left   Node
+	Right  Node
+	Offset int
+}
+
+func (n *Number) Pos() int   { return n.Offset }
+func (n *Variable) Pos() int { return n.Offset }
+func (n *Unary) Pos() int    { return n.Offset }
+func (n *Binary) Pos() int   { return n.Offset }
@@ -0,0 +1,27 @@
+package calc
+
+import "fmt"
+
+// Error is a parse or evaluation error tied to a position in the source expression.
+type Error struct {
+	// Offset is the zero-based byte offset of the offending token.
+	Offset int
+	Msg    string
+	Err    error
+}
+
+func newError(offset int, err error, format string, args ...any) *Error {
+	return &Error{Offset: offset, Msg: fmt.Sprintf(format, args...), Err: err}
+}
+
+// Error reports the message together with the one-based column of the offending token.
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("col %d: %s: %v", e.Offset+1, e.Msg, e.Err)
+	}
+	return fmt.Sprintf("col %d: %s", e.Offset+1, e.Msg)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
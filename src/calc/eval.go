@@ -0,0 +1,121 @@
+package calc
+
+import (
+	"fmt"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// MaxShift is the largest shift amount accepted by the evaluator.
+const MaxShift = 1 << 24
+
+// Vars maps variable names to their values.
+type Vars map[string]bignumbers.BigNumber
+
+// Eval parses and evaluates an infix expression.
+func Eval(expr string) (bignumbers.BigNumber, error) {
+	return EvalVars(expr, nil)
+}
+
+// EvalVars parses and evaluates an infix expression, resolving variables from vars.
+func EvalVars(expr string, vars Vars) (bignumbers.BigNumber, error) {
+	node, err := Parse(expr)
+	if err != nil {
+		return bignumbers.BigNumber{}, err
+	}
+	return EvaluateVars(node, vars)
+}
+
+// Evaluate evaluates a syntax tree produced by Parse.
+func Evaluate(node Node) (bignumbers.BigNumber, error) {
+	return EvaluateVars(node, nil)
+}
+
+// EvaluateVars evaluates a syntax tree produced by Parse, resolving variables from vars.
+// The evaluator never modifies vars.
+func EvaluateVars(node Node, vars Vars) (bignumbers.BigNumber, error) {
+	switch n := node.(type) {
+	case *Number:
+		return n.Value, nil
+	case *Variable:
+		value, ok := vars[n.Name]
+		if !ok {
+			return bignumbers.BigNumber{}, newError(n.Offset, nil, "undefined variable %q", n.Name)
+		}
+		return value, nil
+	case *Unary:
+		operand, err := EvaluateVars(n.Operand, vars)
+		if err != nil {
+			return bignumbers.BigNumber{}, err
+		}
+		return operand.Invert(), nil
+	case *Binary:
+		left, err := EvaluateVars(n.Left, vars)
+		if err != nil {
+			return bignumbers.BigNumber{}, err
+		}
+		right, err := EvaluateVars(n.Right, vars)
+		if err != nil {
+			return bignumbers.BigNumber{}, err
+		}
+		return applyBinary(n, left, right)
+	default:
+		return bignumbers.BigNumber{}, fmt.Errorf("unknown node type %T", node)
+	}
+}
+
+func applyBinary(n *Binary, left, right bignumbers.BigNumber) (bignumbers.BigNumber, error) {
+	switch n.Op {
+	case "+":
+		return left.ADD(right), nil
+	case "-":
+		result, err := left.SUB(right)
+		if err != nil {
+			return bignumbers.BigNumber{}, newError(n.Offset, err, "cannot subtract")
+		}
+		return result, nil
+	case "*":
+		return left.MUL(right), nil
+	case "/", "%":
+		if right.IsZero() {
+			return bignumbers.BigNumber{}, newError(n.Offset, nil, "division by zero")
+		}
+		if n.Op == "/" {
+			return left.DIV(right), nil
+		}
+		return left.MOD(right), nil
+	case "^":
+		return left.XOR(right), nil
+	case "&":
+		return left.AND(right), nil
+	case "|":
+		return left.OR(right), nil
+	case "<<", ">>":
+		shift, ok := shiftAmount(right)
+		if !ok {
+			return bignumbers.BigNumber{}, newError(n.Right.Pos(), nil, "shift amount %s is too large", right.GetDecimal())
+		}
+		if n.Op == "<<" {
+			return left.ShiftL(shift), nil
+		}
+		return left.ShiftR(shift), nil
+	default:
+		return bignumbers.BigNumber{}, newError(n.Offset, nil, "operator %q is not supported", n.Op)
+	}
+}
+
+func shiftAmount(bn bignumbers.BigNumber) (int, bool) {
+	value := uint64(0)
+	for i, block := range bn.GetBlocks() {
+		if i > 0 && block.GetDecimal() != 0 {
+			return 0, false
+		}
+		if i == 0 {
+			value = block.GetDecimal()
+		}
+	}
+	if value > MaxShift {
+		return 0, false
+	}
+	return int(value), true
+}
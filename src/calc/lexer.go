@@ -0,0 +1,89 @@
+package calc
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+// operators lists the recognised operators, two-character operators first.
+var operators = []string{"<<", ">>", "+", "-", "%", "&", "|", "^", "~", "*", "/"}
+
+// tokenize splits the expression into tokens terminated by a tokenEOF token.
+func tokenize(expr string) ([]token, error) {
+	tokens := make([]token, 0)
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", offset: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", offset: i})
+			i++
+		case isDigit(c):
+			start := i
+			for i < len(expr) && (isDigit(expr[i]) || isLetter(expr[i]) || expr[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: expr[start:i], offset: start})
+		case isLetter(c) || c == '_':
+			start := i
+			for i < len(expr) && (isDigit(expr[i]) || isLetter(expr[i]) || expr[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: expr[start:i], offset: start})
+		default:
+			op := matchOperator(expr[i:])
+			if op == "" {
+				return nil, newError(i, nil, "unexpected character %q", rune(c))
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, offset: i})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, offset: len(expr)}), nil
+}
+
+func matchOperator(s string) string {
+	for _, op := range operators {
+		if len(s) >= len(op) && s[:len(op)] == op {
+			return op
+		}
+	}
+	return ""
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// IsIdentifier reports whether name is a valid variable name.
+func IsIdentifier(name string) bool {
+	if name == "" || isDigit(name[0]) {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isDigit(name[i]) && !isLetter(name[i]) && name[i] != '_' {
+			return false
+		}
+	}
+	return true
+}
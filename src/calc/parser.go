@@ -0,0 +1,140 @@
+package calc
+
+import (
+	"errors"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// precedence follows Go: multiplicative-level operators bind tighter than additive-level ones.
+var precedence = map[string]int{
+	"|":  1,
+	"^":  1,
+	"+":  1,
+	"-":  1,
+	"*":  2,
+	"/":  2,
+	"%":  2,
+	"&":  2,
+	"<<": 2,
+	">>": 2,
+}
+
+const maxPrecedence = 2
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses an infix expression into a syntax tree.
+//
+// Supported binary operators, from lowest to highest precedence:
+//
+//	|  ^  +  -
+//	*  /  %  &  <<  >>
+//
+// Operators of equal precedence are left-associative. The unary operators ~ and ^
+// perform bitwise inversion. Literals may be decimal, 0x-prefixed hex or 0b-prefixed binary.
+// Identifiers are parsed as variables.
+func Parse(expr string) (Node, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	node, err := p.parseBinary(1)
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, newError(tok.offset, nil, "unexpected %q", tok.text)
+	}
+	return node, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// parseBinary parses a chain of binary operations whose precedence is at least minPrecedence.
+func (p *parser) parseBinary(minPrecedence int) (Node, error) {
+	if minPrecedence > maxPrecedence {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(minPrecedence + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokenOperator {
+			return left, nil
+		}
+		prec, ok := precedence[tok.text]
+		if !ok {
+			return nil, newError(tok.offset, nil, "unexpected %q", tok.text)
+		}
+		if prec != minPrecedence {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseBinary(minPrecedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: tok.text, Left: left, Right: right, Offset: tok.offset}
+	}
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		var value bignumbers.BigNumber
+		if err := value.UnmarshalText([]byte(tok.text)); err != nil {
+			// Keep the *ParseError, whose message already names the literal, as the cause.
+			var parseErr *bignumbers.ParseError
+			if errors.As(err, &parseErr) {
+				err = parseErr
+			}
+			return nil, newError(tok.offset, err, "invalid number %q", tok.text)
+		}
+		return &Number{Value: value, Offset: tok.offset}, nil
+	case tokenIdent:
+		return &Variable{Name: tok.text, Offset: tok.offset}, nil
+	case tokenLParen:
+		node, err := p.parseBinary(1)
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, newError(closing.offset, nil, "expected \")\" to close \"(\" at col %d", tok.offset+1)
+		}
+		return node, nil
+	case tokenOperator:
+		switch tok.text {
+		case "~", "^":
+			operand, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			return &Unary{Op: "~", Operand: operand, Offset: tok.offset}, nil
+		case "-":
+			return nil, newError(tok.offset, nil, "negative numbers are not supported")
+		}
+		return nil, newError(tok.offset, nil, "unexpected %q", tok.text)
+	case tokenEOF:
+		return nil, newError(tok.offset, nil, "unexpected end of expression")
+	default:
+		return nil, newError(tok.offset, nil, "unexpected %q", tok.text)
+	}
+}
@@ -0,0 +1,278 @@
+// Package cli implements the bn command-line calculator.
+package cli
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+	"github.com/danielost/big-numbers/src/calc"
+)
+
+// command describes a bn subcommand.
+type command struct {
+	usage string
+	help  string
+	run   func(operands []string) ([]bignumbers.BigNumber, error)
+}
+
+var commands = map[string]command{
+	"add":    {usage: "a b [c...]", help: "addition", run: fold(func(a, b bignumbers.BigNumber) (bignumbers.BigNumber, error) { return a.ADD(b), nil })},
+	"sub":    {usage: "a b [c...]", help: "subtraction", run: fold(func(a, b bignumbers.BigNumber) (bignumbers.BigNumber, error) { return a.SUB(b) })},
+	"mul":    {usage: "a b [c...]", help: "multiplication", run: fold(func(a, b bignumbers.BigNumber) (bignumbers.BigNumber, error) { return a.MUL(b), nil })},
+	"div":    {usage: "a b", help: "integer division", run: runDiv},
+	"mod":    {usage: "a m", help: "modulo", run: runMod},
+	"powmod": {usage: "a e m", help: "modular exponentiation a^e mod m", run: runPowMod},
+	"xor":    {usage: "a b [c...]", help: "bitwise exclusive or", run: fold(func(a, b bignumbers.BigNumber) (bignumbers.BigNumber, error) { return a.XOR(b), nil })},
+	"and":    {usage: "a b [c...]", help: "bitwise and", run: fold(func(a, b bignumbers.BigNumber) (bignumbers.BigNumber, error) { return a.AND(b), nil })},
+	"or":     {usage: "a b [c...]", help: "bitwise or", run: fold(func(a, b bignumbers.BigNumber) (bignumbers.BigNumber, error) { return a.OR(b), nil })},
+	"inv":    {usage: "a [b...]", help: "bitwise inversion of each operand", run: runInv},
+	"shl":    {usage: "a n", help: "shift a to the left by n bits", run: runShift(func(a bignumbers.BigNumber, n int) bignumbers.BigNumber { return a.ShiftL(n) })},
+	"shr":    {usage: "a n", help: "shift a to the right by n bits", run: runShift(func(a bignumbers.BigNumber, n int) bignumbers.BigNumber { return a.ShiftR(n) })},
+	"eval":   {usage: "expression", help: "evaluate an infix expression, e.g. '(0xff ^ 0b1010) << 3'", run: runEval},
+	"conv":   {usage: "a [b...]", help: "print each operand in the output base", run: runConv},
+}
+
+// outputFormats maps the --out values to fmt verbs.
+var outputFormats = map[string]string{
+	"hex": "%#x",
+	"bin": "%#b",
+	"dec": "%d",
+	"oct": "%O",
+}
+
+// Run executes bn with the given arguments and returns the process exit code.
+func Run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("bn", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	out := fs.String("out", "hex", "output base: hex, dec, bin or oct")
+	fs.Usage = func() { usage(stderr, fs) }
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		usage(stderr, fs)
+		return 2
+	}
+	name := fs.Arg(0)
+	if name == "help" {
+		usage(stdout, fs)
+		return 0
+	}
+	if name == "repl" {
+		return runRepl(fs.Args()[1:], stdin, stdout, stderr)
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "bn: unknown command %q\n", name)
+		usage(stderr, fs)
+		return 2
+	}
+	if err := fs.Parse(fs.Args()[1:]); err != nil {
+		return 2
+	}
+	format, ok := outputFormats[*out]
+	if !ok {
+		fmt.Fprintf(stderr, "bn: unknown output base %q\n", *out)
+		return 2
+	}
+
+	operands := fs.Args()
+	if len(operands) == 0 {
+		var err error
+		if operands, err = readOperands(stdin, name == "eval"); err != nil {
+			fmt.Fprintf(stderr, "bn: %v\n", err)
+			return 1
+		}
+	}
+
+	results, err := cmd.run(operands)
+	if err != nil {
+		fmt.Fprintf(stderr, "bn %s: %v\n", name, err)
+		return 1
+	}
+	for _, result := range results {
+		fmt.Fprintf(stdout, format+"\n", result)
+	}
+	return 0
+}
+
+func usage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintln(w, "usage: bn [--out hex|dec|bin|oct] <command> [operands...]")
+	fmt.Fprintln(w, "\nOperands are decimal, 0x-prefixed hex or 0b-prefixed binary. When no operands")
+	fmt.Fprintln(w, "are given they are read from standard input, separated by whitespace.")
+	fmt.Fprintln(w, "\nCommands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-6s %-12s %s\n", name, commands[name].usage, commands[name].help)
+	}
+	fmt.Fprintf(w, "  %-6s %-12s %s\n", "repl", "", "start an interactive session, see :help inside it")
+	fmt.Fprintln(w, "\nFlags:")
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
+
+// readOperands reads whitespace-separated operands from r. If whole is set, the entire input is a single operand.
+func readOperands(r io.Reader, whole bool) ([]string, error) {
+	if whole {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		return []string{strings.TrimSpace(string(data))}, nil
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	scanner.Split(bufio.ScanWords)
+	operands := make([]string, 0)
+	for scanner.Scan() {
+		operands = append(operands, scanner.Text())
+	}
+	return operands, scanner.Err()
+}
+
+// parseOperand parses a decimal, 0x-prefixed hex or 0b-prefixed binary operand.
+func parseOperand(operand string) (bignumbers.BigNumber, error) {
+	var bn bignumbers.BigNumber
+	if err := bn.UnmarshalText([]byte(operand)); err != nil {
+		return bignumbers.BigNumber{}, fmt.Errorf("invalid operand %q", operand)
+	}
+	return bn, nil
+}
+
+func parseOperands(operands []string) ([]bignumbers.BigNumber, error) {
+	values := make([]bignumbers.BigNumber, len(operands))
+	for i, operand := range operands {
+		value, err := parseOperand(operand)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func expectOperands(operands []string, n int) error {
+	if len(operands) != n {
+		return fmt.Errorf("expected %d operands but got %d", n, len(operands))
+	}
+	return nil
+}
+
+// fold applies a binary operation from left to right over at least two operands.
+func fold(operation func(a, b bignumbers.BigNumber) (bignumbers.BigNumber, error)) func([]string) ([]bignumbers.BigNumber, error) {
+	return func(operands []string) ([]bignumbers.BigNumber, error) {
+		if len(operands) < 2 {
+			return nil, fmt.Errorf("expected at least 2 operands but got %d", len(operands))
+		}
+		values, err := parseOperands(operands)
+		if err != nil {
+			return nil, err
+		}
+		result := values[0]
+		for _, value := range values[1:] {
+			if result, err = operation(result, value); err != nil {
+				return nil, err
+			}
+		}
+		return []bignumbers.BigNumber{result}, nil
+	}
+}
+
+func runMod(operands []string) ([]bignumbers.BigNumber, error) {
+	if err := expectOperands(operands, 2); err != nil {
+		return nil, err
+	}
+	values, err := parseOperands(operands)
+	if err != nil {
+		return nil, err
+	}
+	if values[1].IsZero() {
+		return nil, bignumbers.ErrDivisionByZero
+	}
+	return []bignumbers.BigNumber{values[0].MOD(values[1])}, nil
+}
+
+func runDiv(operands []string) ([]bignumbers.BigNumber, error) {
+	if err := expectOperands(operands, 2); err != nil {
+		return nil, err
+	}
+	values, err := parseOperands(operands)
+	if err != nil {
+		return nil, err
+	}
+	if values[1].IsZero() {
+		return nil, bignumbers.ErrDivisionByZero
+	}
+	return []bignumbers.BigNumber{values[0].DIV(values[1])}, nil
+}
+
+func runPowMod(operands []string) ([]bignumbers.BigNumber, error) {
+	if err := expectOperands(operands, 3); err != nil {
+		return nil, err
+	}
+	values, err := parseOperands(operands)
+	if err != nil {
+		return nil, err
+	}
+	if values[2].IsZero() {
+		return nil, bignumbers.ErrDivisionByZero
+	}
+	return []bignumbers.BigNumber{values[0].ModExp(values[1], values[2])}, nil
+}
+
+func runInv(operands []string) ([]bignumbers.BigNumber, error) {
+	if len(operands) == 0 {
+		return nil, fmt.Errorf("expected at least 1 operand")
+	}
+	values, err := parseOperands(operands)
+	if err != nil {
+		return nil, err
+	}
+	for i := range values {
+		values[i] = values[i].Invert()
+	}
+	return values, nil
+}
+
+func runShift(operation func(bignumbers.BigNumber, int) bignumbers.BigNumber) func([]string) ([]bignumbers.BigNumber, error) {
+	return func(operands []string) ([]bignumbers.BigNumber, error) {
+		if err := expectOperands(operands, 2); err != nil {
+			return nil, err
+		}
+		value, err := parseOperand(operands[0])
+		if err != nil {
+			return nil, err
+		}
+		n, err := strconv.Atoi(operands[1])
+		if err != nil || n < 0 || n > calc.MaxShift {
+			return nil, fmt.Errorf("invalid shift amount %q", operands[1])
+		}
+		return []bignumbers.BigNumber{operation(value, n)}, nil
+	}
+}
+
+func runEval(operands []string) ([]bignumbers.BigNumber, error) {
+	result, err := calc.Eval(strings.Join(operands, " "))
+	if err != nil {
+		return nil, err
+	}
+	return []bignumbers.BigNumber{result}, nil
+}
+
+func runConv(operands []string) ([]bignumbers.BigNumber, error) {
+	if len(operands) == 0 {
+		return nil, fmt.Errorf("expected at least 1 operand")
+	}
+	return parseOperands(operands)
+}
@@ -0,0 +1,267 @@
+package cli
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+	"github.com/danielost/big-numbers/src/calc"
+)
+
+// maxHistory is the number of most recent history entries kept in the history file.
+const maxHistory = 1000
+
+// lastResult is the variable that holds the result of the most recent expression.
+const lastResult = "_"
+
+// replBases lists the bases the REPL can display, in display order.
+var replBases = []string{"hex", "dec", "bin", "oct"}
+
+// repl is the state of an interactive session.
+type repl struct {
+	vars        calc.Vars
+	bases       []string
+	history     []string
+	historyFile string
+	out         io.Writer
+}
+
+// runRepl starts an interactive session reading lines from stdin.
+func runRepl(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("bn repl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	historyFile := fs.String("history", defaultHistoryFile(), "file to persist the history to; empty disables persistence")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 0 {
+		fmt.Fprintf(stderr, "bn repl: unexpected arguments %v\n", fs.Args())
+		return 2
+	}
+
+	r := &repl{
+		vars:        calc.Vars{},
+		bases:       []string{"hex", "dec"},
+		historyFile: *historyFile,
+		out:         stdout,
+	}
+	if err := r.loadHistory(); err != nil {
+		fmt.Fprintf(stderr, "bn repl: %v\n", err)
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	fmt.Fprint(stdout, "bn> ")
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			if err := r.appendHistory(line); err != nil {
+				fmt.Fprintf(stderr, "bn repl: %v\n", err)
+			}
+			quit, err := r.execute(line)
+			if err != nil {
+				fmt.Fprintf(stdout, "error: %v\n", err)
+			}
+			if quit {
+				return 0
+			}
+		}
+		fmt.Fprint(stdout, "bn> ")
+	}
+	fmt.Fprintln(stdout)
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "bn repl: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func defaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".bn_history")
+}
+
+// execute runs a single line and reports whether the session should end.
+func (r *repl) execute(line string) (bool, error) {
+	if strings.HasPrefix(line, ":") {
+		return r.executeCommand(line)
+	}
+	if name, expr, ok := strings.Cut(line, "="); ok {
+		name = strings.TrimSpace(name)
+		if !calc.IsIdentifier(name) {
+			return false, fmt.Errorf("invalid variable name %q", name)
+		}
+		value, err := calc.EvalVars(expr, r.vars)
+		if err != nil {
+			return false, err
+		}
+		r.vars[name] = value
+		r.printValue(name+" = ", value)
+		return false, nil
+	}
+	value, err := calc.EvalVars(line, r.vars)
+	if err != nil {
+		return false, err
+	}
+	r.vars[lastResult] = value
+	r.printValue("", value)
+	return false, nil
+}
+
+func (r *repl) executeCommand(line string) (bool, error) {
+	command, rest, _ := strings.Cut(line, " ")
+	rest = strings.TrimSpace(rest)
+	switch command {
+	case ":q", ":quit", ":exit":
+		return true, nil
+	case ":help":
+		r.printHelp()
+	case ":base":
+		return false, r.setBases(rest)
+	case ":bits":
+		value, err := r.evalArgument(rest)
+		if err != nil {
+			return false, err
+		}
+		fmt.Fprintf(r.out, "%d bits\n", value.BitLen())
+	case ":blocks":
+		value, err := r.evalArgument(rest)
+		if err != nil {
+			return false, err
+		}
+		r.printBlocks(value)
+	case ":vars":
+		names := make([]string, 0, len(r.vars))
+		for name := range r.vars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			r.printValue(name+" = ", r.vars[name])
+		}
+	case ":history":
+		for i, entry := range r.history {
+			fmt.Fprintf(r.out, "%5d  %s\n", i+1, entry)
+		}
+	default:
+		return false, fmt.Errorf("unknown command %q, type :help for the list of commands", command)
+	}
+	return false, nil
+}
+
+// evalArgument evaluates the argument of an inspection command, defaulting to the last result.
+func (r *repl) evalArgument(expr string) (bignumbers.BigNumber, error) {
+	if expr == "" {
+		expr = lastResult
+	}
+	return calc.EvalVars(expr, r.vars)
+}
+
+func (r *repl) setBases(arg string) error {
+	if arg == "" {
+		fmt.Fprintf(r.out, "bases: %s\n", strings.Join(r.bases, " "))
+		return nil
+	}
+	requested := strings.Fields(strings.ReplaceAll(arg, ",", " "))
+	bases := make([]string, 0, len(requested))
+	for _, base := range requested {
+		if _, ok := outputFormats[base]; !ok {
+			return fmt.Errorf("unknown base %q, expected one of %s", base, strings.Join(replBases, ", "))
+		}
+		bases = append(bases, base)
+	}
+	r.bases = bases
+	return nil
+}
+
+// printValue prints the value in every selected base side by side.
+func (r *repl) printValue(prefix string, value bignumbers.BigNumber) {
+	columns := make([]string, len(r.bases))
+	for i, base := range r.bases {
+		columns[i] = fmt.Sprintf("%s "+outputFormats[base], base, value)
+	}
+	fmt.Fprintf(r.out, "%s%s\n", prefix, strings.Join(columns, "  "))
+}
+
+// printBlocks prints the []Uint layout of the value, least significant block first.
+func (r *repl) printBlocks(value bignumbers.BigNumber) {
+	blocks := value.GetBlocks()
+	fmt.Fprintf(r.out, "%d blocks\n", len(blocks))
+	for i, block := range blocks {
+		fmt.Fprintf(r.out, "[%d] 0x%016x  %d\n", i, block.GetDecimal(), block.GetDecimal())
+	}
+}
+
+func (r *repl) printHelp() {
+	fmt.Fprintln(r.out, `Enter an expression such as (0xff ^ 0b1010) << 3 + 12345 % 7 to evaluate it,
+or name = expression to assign a variable. The last result is stored in _.
+
+Commands:
+  :base [hex dec bin oct]  show or select the bases results are displayed in
+  :bits [expression]       show the bit length of a value
+  :blocks [expression]     show the []Uint block layout of a value
+  :vars                    list the variables
+  :history                 show the history
+  :help                    show this help
+  :quit                    end the session`)
+}
+
+func (r *repl) loadHistory() error {
+	if r.historyFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(r.historyFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot read history: %w", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			r.history = append(r.history, line)
+		}
+	}
+	if len(r.history) > maxHistory {
+		r.history = r.history[len(r.history)-maxHistory:]
+		return r.writeHistory()
+	}
+	return nil
+}
+
+func (r *repl) appendHistory(line string) error {
+	r.history = append(r.history, line)
+	if r.historyFile == "" {
+		return nil
+	}
+	if len(r.history) > maxHistory {
+		r.history = r.history[len(r.history)-maxHistory:]
+		return r.writeHistory()
+	}
+	f, err := os.OpenFile(r.historyFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("cannot write history: %w", err)
+	}
+	defer f.Close()
+	if _, err := fmt.Fprintln(f, line); err != nil {
+		return fmt.Errorf("cannot write history: %w", err)
+	}
+	return nil
+}
+
+func (r *repl) writeHistory() error {
+	data := strings.Join(r.history, "\n") + "\n"
+	if err := os.WriteFile(r.historyFile, []byte(data), 0o600); err != nil {
+		return fmt.Errorf("cannot write history: %w", err)
+	}
+	return nil
+}
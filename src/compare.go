@@ -0,0 +1,53 @@
+package bignumbers
+
+// Cmp compares the BigNumber with another BigNumber and returns -1, 0 or 1
+// if it is less than, equal to or greater than the other one.
+// Leading zero blocks are ignored, so the result does not depend on how the values were produced.
+func (bn *BigNumber) Cmp(other BigNumber) int {
+	thisBlocks := norm(bn.blocks)
+	otherBlocks := norm(other.blocks)
+	if len(thisBlocks) != len(otherBlocks) {
+		if len(thisBlocks) < len(otherBlocks) {
+			return -1
+		}
+		return 1
+	}
+	for i := len(thisBlocks) - 1; i >= 0; i-- {
+		if thisBlocks[i].GetDecimal() < otherBlocks[i].GetDecimal() {
+			return -1
+		}
+		if thisBlocks[i].GetDecimal() > otherBlocks[i].GetDecimal() {
+			return 1
+		}
+	}
+	return 0
+}
+
+// Equal checks if the BigNumber is equal to another BigNumber.
+func (bn *BigNumber) Equal(other BigNumber) bool {
+	return bn.Cmp(other) == 0
+}
+
+// GreaterThan checks if the BigNumber is greater than another BigNumber.
+func (bn *BigNumber) GreaterThan(other BigNumber) bool {
+	return bn.Cmp(other) > 0
+}
+
+// IsZero checks if the BigNumber is zero.
+func (bn *BigNumber) IsZero() bool {
+	return len(norm(bn.blocks)) == 0
+}
+
+// IsOne checks if the BigNumber is one.
+func (bn *BigNumber) IsOne() bool {
+	blocks := norm(bn.blocks)
+	return len(blocks) == 1 && blocks[0].GetDecimal() == 1
+}
+
+// Sign returns 0 if the BigNumber is zero and 1 otherwise. BigNumber values are never negative.
+func (bn *BigNumber) Sign() int {
+	if bn.IsZero() {
+		return 0
+	}
+	return 1
+}
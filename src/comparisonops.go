@@ -0,0 +1,11 @@
+package bignumbers
+
+type ComparisonOps interface {
+	Cmp(BigNumber) int
+	Equal(BigNumber) bool
+	LessThan(BigNumber) bool
+	GreaterThan(BigNumber) bool
+	IsZero() bool
+	IsOne() bool
+	Sign() int
+}
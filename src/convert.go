@@ -0,0 +1,85 @@
+package bignumbers
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// FromUint64 returns a BigNumber holding x.
+func FromUint64(x uint64) (result BigNumber) {
+	result.setBlocks([]Uint{{x}})
+	return
+}
+
+// FromInt64 returns a BigNumber holding x. It returns ErrNegativeValue if x is negative.
+func FromInt64(x int64) (BigNumber, error) {
+	if x < 0 {
+		return BigNumber{}, fmt.Errorf("from %d: %w", x, ErrNegativeValue)
+	}
+	return FromUint64(uint64(x)), nil
+}
+
+// FromFloat64 returns a BigNumber holding x truncated towards zero.
+// It returns ErrNotFinite for NaN and infinities and ErrNegativeValue for negative values.
+func FromFloat64(x float64) (BigNumber, error) {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return BigNumber{}, fmt.Errorf("from %v: %w", x, ErrNotFinite)
+	}
+	if x < 0 {
+		return BigNumber{}, fmt.Errorf("from %v: %w", x, ErrNegativeValue)
+	}
+	x = math.Trunc(x)
+	if x < 1<<64 {
+		return FromUint64(uint64(x)), nil
+	}
+	// x = mantissa * 2^(exp-53) with a 53-bit mantissa, and exp > 64 here.
+	fraction, exp := math.Frexp(x)
+	mantissa := FromUint64(uint64(math.Ldexp(fraction, 53)))
+	return BigNumber{blocks: shiftLeftBlocks(mantissa.blocks, exp-53)}, nil
+}
+
+// Uint64 returns the BigNumber as a uint64 and whether it fits exactly.
+// If it does not fit, the result holds the 64 least significant bits.
+func (bn *BigNumber) Uint64() (uint64, bool) {
+	low := blockAt(bn.blocks, 0)
+	return low.GetDecimal(), bn.IsUint64()
+}
+
+// IsUint64 reports whether the BigNumber can be represented as a uint64.
+func (bn *BigNumber) IsUint64() bool {
+	return bn.BitLen() <= 64
+}
+
+// Float64 returns the float64 value nearest to the BigNumber, rounding half to even, and the
+// accuracy of the result. Values too large for a float64 return +Inf with big.Above.
+func (bn *BigNumber) Float64() (float64, big.Accuracy) {
+	bitLen := bn.BitLen()
+	if bitLen == 0 {
+		return 0, big.Exact
+	}
+	// top holds the 64 most significant bits, left-aligned; sticky records whether any bit below them is set.
+	var top uint64
+	sticky := false
+	if bitLen <= 64 {
+		top = bn.blocks[0].GetDecimal() << (64 - bitLen)
+	} else {
+		top = shiftRightBlocks(bn.blocks, bitLen-64)[0].GetDecimal()
+		sticky = bn.TrailingZeros() < bitLen-64
+	}
+	mantissa, rest := top>>11, top&(1<<11-1)
+	const half = 1 << 10
+	accuracy := big.Below
+	switch {
+	case rest == 0 && !sticky:
+		accuracy = big.Exact
+	case rest > half || (rest == half && (sticky || mantissa&1 == 1)):
+		mantissa++
+		accuracy = big.Above
+	}
+	f := math.Ldexp(float64(mantissa), bitLen-53)
+	if math.IsInf(f, 1) {
+		accuracy = big.Above
+	}
+	return f, accuracy
+}
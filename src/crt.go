@@ -0,0 +1,182 @@
+package bignumbers
+
+import (
+	"fmt"
+)
+
+// CRT solves the system x ≡ residues[i] (mod moduli[i]) with the Chinese Remainder Theorem.
+// The moduli do not need to be pairwise coprime. It returns the solution in [0, m) together with
+// m, the least common multiple of the moduli. If two congruences contradict each other the
+// returned error wraps ErrNoSolution.
+func CRT(residues, moduli []BigNumber) (x, m BigNumber, err error) {
+	if len(residues) != len(moduli) {
+		return BigNumber{}, BigNumber{}, fmt.Errorf("crt: %d residues but %d moduli", len(residues), len(moduli))
+	}
+	m = FromUint64(1)
+	for i := range moduli {
+		if moduli[i].IsZero() {
+			return BigNumber{}, BigNumber{}, fmt.Errorf("crt: modulus %d is zero", i)
+		}
+		x, m, err = crtMerge(x, m, residues[i].MOD(moduli[i]), moduli[i])
+		if err != nil {
+			return BigNumber{}, BigNumber{}, fmt.Errorf("crt: congruence %d: %w", i, err)
+		}
+	}
+	return x, m, nil
+}
+
+// crtMerge combines x ≡ a1 (mod m1) and x ≡ a2 (mod m2), with a1 < m1 and a2 < m2, into a single
+// congruence modulo lcm(m1, m2).
+func crtMerge(a1, m1, a2, m2 BigNumber) (BigNumber, BigNumber, error) {
+	g := m1.GCD(m2)
+	// x = a1 + m1*t, so m1*t ≡ a2 - a1 (mod m2), which is solvable only if g divides a2 - a1.
+	diff := subMod(a2, a1.MOD(m2), m2)
+	quotient, remainder := diff.DivMod(g)
+	if !remainder.IsZero() {
+		return BigNumber{}, BigNumber{}, fmt.Errorf("%s and %s disagree modulo %s: %w", a1.GetDecimal(), a2.GetDecimal(), g.GetDecimal(), ErrNoSolution)
+	}
+	m2g := m2.DIV(g)
+	m1g := m1.DIV(g)
+	inverse, err := m1g.ModInverse(m2g)
+	if err != nil {
+		return BigNumber{}, BigNumber{}, err
+	}
+	t := mulMod(quotient, inverse, m2g)
+	lcm := m1.MUL(m2g)
+	step := m1.MUL(t)
+	return a1.ADD(step), lcm, nil
+}
+
+// RNSBasis is a fixed set of pairwise coprime moduli for a residue number system.
+// A value is represented by its residues modulo each of them, which makes addition and
+// multiplication independent per modulus. An RNSBasis is not modified after creation and
+// may be shared between goroutines.
+type RNSBasis struct {
+	moduli []BigNumber
+	// inverses[i] is the inverse of moduli[0]*...*moduli[i-1] modulo moduli[i], used by Garner's algorithm.
+	inverses []BigNumber
+	product  BigNumber
+}
+
+// NewRNSBasis returns a basis over the given moduli, which must be pairwise coprime and greater than one.
+func NewRNSBasis(moduli ...BigNumber) (*RNSBasis, error) {
+	if len(moduli) == 0 {
+		return nil, fmt.Errorf("rns: empty basis")
+	}
+	basis := &RNSBasis{
+		moduli:   make([]BigNumber, len(moduli)),
+		inverses: make([]BigNumber, len(moduli)),
+		product:  FromUint64(1),
+	}
+	for i := range moduli {
+		if moduli[i].CmpUint64(1) <= 0 {
+			return nil, fmt.Errorf("rns: modulus %d must be greater than one", i)
+		}
+		basis.moduli[i] = moduli[i].Clone()
+		inverse, err := basis.product.ModInverse(moduli[i])
+		if err != nil {
+			return nil, fmt.Errorf("rns: modulus %d is not coprime with the previous moduli", i)
+		}
+		basis.inverses[i] = inverse
+		basis.product = basis.product.MUL(moduli[i])
+	}
+	return basis, nil
+}
+
+// Moduli returns a copy of the moduli of the basis.
+func (b *RNSBasis) Moduli() []BigNumber {
+	moduli := make([]BigNumber, len(b.moduli))
+	for i := range b.moduli {
+		moduli[i] = b.moduli[i].Clone()
+	}
+	return moduli
+}
+
+// Product returns the product of the moduli. Values are represented modulo the product.
+func (b *RNSBasis) Product() BigNumber {
+	return b.product.Clone()
+}
+
+// FromBigNumber returns the residues of x over the basis.
+func (b *RNSBasis) FromBigNumber(x BigNumber) RNS {
+	residues := make([]BigNumber, len(b.moduli))
+	for i := range b.moduli {
+		residues[i] = x.MOD(b.moduli[i])
+	}
+	return RNS{basis: b, residues: residues}
+}
+
+// FromResidues returns the number with the given residues over the basis.
+// Residues that are not smaller than their modulus are reduced.
+func (b *RNSBasis) FromResidues(residues []BigNumber) (RNS, error) {
+	if len(residues) != len(b.moduli) {
+		return RNS{}, fmt.Errorf("rns: %d residues for a basis of %d moduli", len(residues), len(b.moduli))
+	}
+	reduced := make([]BigNumber, len(residues))
+	for i := range residues {
+		reduced[i] = residues[i].MOD(b.moduli[i])
+	}
+	return RNS{basis: b, residues: reduced}, nil
+}
+
+// RNS is a number in a residue number system. The zero value is not usable;
+// obtain values from an RNSBasis.
+type RNS struct {
+	basis    *RNSBasis
+	residues []BigNumber
+}
+
+// Residues returns a copy of the residues of the number.
+func (r *RNS) Residues() []BigNumber {
+	residues := make([]BigNumber, len(r.residues))
+	for i := range r.residues {
+		residues[i] = r.residues[i].Clone()
+	}
+	return residues
+}
+
+// Add returns the sum of the two numbers modulo the product of the basis.
+// It panics if the numbers use different bases.
+func (r *RNS) Add(other RNS) RNS {
+	return r.apply(other, addMod)
+}
+
+// Mul returns the product of the two numbers modulo the product of the basis.
+// It panics if the numbers use different bases.
+func (r *RNS) Mul(other RNS) RNS {
+	return r.apply(other, mulMod)
+}
+
+// apply combines the residues of r and other one modulus at a time.
+func (r *RNS) apply(other RNS, operation func(a, b, m BigNumber) BigNumber) RNS {
+	if r.basis != other.basis {
+		panic("bignumbers: RNS values over different bases")
+	}
+	residues := make([]BigNumber, len(r.residues))
+	for i, m := range r.basis.moduli {
+		residues[i] = operation(r.residues[i], other.residues[i], m)
+	}
+	return RNS{basis: r.basis, residues: residues}
+}
+
+// BigNumber converts the number back to positional form with Garner's algorithm.
+// The result is in [0, Product()).
+func (r *RNS) BigNumber() BigNumber {
+	// x = v[0] + v[1]*m[0] + v[2]*m[0]*m[1] + ..., where every mixed-radix digit v[i] is below m[i].
+	moduli := r.basis.moduli
+	digits := make([]BigNumber, len(moduli))
+	for i, m := range moduli {
+		// Evaluate the digits found so far modulo m with Horner's rule.
+		var partial BigNumber
+		for j := i - 1; j >= 0; j-- {
+			partial = addMod(mulMod(partial, moduli[j], m), digits[j].MOD(m), m)
+		}
+		digits[i] = mulMod(subMod(r.residues[i], partial, m), r.basis.inverses[i], m)
+	}
+	var result BigNumber
+	for i := len(moduli) - 1; i >= 0; i-- {
+		result = result.MUL(moduli[i])
+		result = result.ADD(digits[i])
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+package bignumbers
+
+import (
+	"strconv"
+)
+
+const (
+	// decimalChunkDigits is the number of decimal digits that always fit into a single uint64.
+	decimalChunkDigits = 19
+	// decimalChunkBase is 10^decimalChunkDigits.
+	decimalChunkBase uint64 = 10000000000000000000
+)
+
+// SetDecimal sets the value of the BigNumber using a decimal string. '_' digit separators are allowed.
+func (bn *BigNumber) SetDecimal(decimal string) error {
+	decimal, err := parseDigits(decimal, 10, "", 0)
+	if err != nil {
+		return err
+	}
+	blocks := make([]Uint, 0)
+	chunkSize := len(decimal) % decimalChunkDigits
+	if chunkSize == 0 {
+		chunkSize = decimalChunkDigits
+	}
+	for start := 0; start < len(decimal); start += chunkSize {
+		if start > 0 {
+			chunkSize = decimalChunkDigits
+		}
+		multiplier := uint64(1)
+		value := uint64(0)
+		for _, digit := range decimal[start : start+chunkSize] {
+			multiplier *= 10
+			value = value*10 + uint64(digit-'0')
+		}
+		blocks = mulAddBlocks(blocks, multiplier, value)
+	}
+	bn.setBlocks(blocks)
+	return nil
+}
+
+// GetDecimal returns the decimal representation of the BigNumber.
+func (bn *BigNumber) GetDecimal() (decimal string) {
+	blocks := bn.blocks
+	for len(blocks) > 0 {
+		var chunk uint64
+		blocks, chunk = divModBlocks(blocks, decimalChunkBase)
+		chunkValue := strconv.FormatUint(chunk, 10)
+		if len(blocks) > 0 {
+			chunkValue = AddLeadingZeros(chunkValue, decimalChunkDigits)
+		}
+		decimal = chunkValue + decimal
+	}
+	return
+}
@@ -0,0 +1,192 @@
+package bignumbers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+)
+
+// dlogRhoBatch is the number of rho steps taken between checks of the context.
+const dlogRhoBatch = 1024
+
+// dlogBSGSLimit is the largest prime order solved with baby-step giant-step, whose table holds
+// about sqrt(order) entries. Larger prime orders use Pollard's rho, which needs no memory.
+const dlogBSGSLimit = 1 << 32
+
+// DiscreteLog returns the smallest x >= 0 with g^x ≡ h (mod p) for a prime p.
+// The order of g is found by factoring p-1, and Pohlig-Hellman reduces the problem to subgroups
+// of prime order, which are solved with baby-step giant-step when they are small and Pollard's
+// rho otherwise. The running time is dominated by the square root of the largest prime factor
+// of the order of g. If h is not a power of g the returned error wraps ErrNoSolution; if ctx is
+// done first, which factoring p-1 may require, it wraps ctx.Err().
+func DiscreteLog(ctx context.Context, g, h, p BigNumber) (BigNumber, error) {
+	if !p.ProbablyPrime(primalityRounds) {
+		return BigNumber{}, fmt.Errorf("discrete log: modulus %s is not prime", p.GetDecimal())
+	}
+	g, h = g.MOD(p), h.MOD(p)
+	if g.IsZero() {
+		return BigNumber{}, fmt.Errorf("discrete log: base is divisible by %s", p.GetDecimal())
+	}
+	if h.IsZero() {
+		return BigNumber{}, fmt.Errorf("discrete log: argument is divisible by %s: %w", p.GetDecimal(), ErrNoSolution)
+	}
+	pMinusOne, _ := p.SubUint64(1)
+	factors, err := Factor(ctx, pMinusOne)
+	if err != nil {
+		return BigNumber{}, fmt.Errorf("discrete log: %w", err)
+	}
+
+	// The order of g divides p-1; drop the prime factors it does not need.
+	order := pMinusOne
+	var orderFactors []PrimeFactor
+	for _, f := range factors {
+		exponent := f.Exponent
+		for exponent > 0 {
+			reduced := order.DIV(f.Prime)
+			power := g.ModExp(reduced, p)
+			if !power.IsOne() {
+				break
+			}
+			order = reduced
+			exponent--
+		}
+		if exponent > 0 {
+			orderFactors = append(orderFactors, PrimeFactor{Prime: f.Prime, Exponent: exponent})
+		}
+	}
+	// The multiplicative group is cyclic, so h is a power of g exactly when its order divides that of g.
+	if power := h.ModExp(order, p); !power.IsOne() {
+		return BigNumber{}, fmt.Errorf("discrete log: %s is not a power of %s modulo %s: %w", h.GetDecimal(), g.GetDecimal(), p.GetDecimal(), ErrNoSolution)
+	}
+
+	s := &dlogSolver{ctx: ctx, p: p, rng: rand.New(rand.NewSource(1))}
+	residues := make([]BigNumber, len(orderFactors))
+	moduli := make([]BigNumber, len(orderFactors))
+	for i, f := range orderFactors {
+		residues[i], moduli[i], err = s.primePower(g, h, order, f)
+		if err != nil {
+			return BigNumber{}, err
+		}
+	}
+	x, _, err := CRT(residues, moduli)
+	if err != nil {
+		return BigNumber{}, fmt.Errorf("discrete log: %w", err)
+	}
+	return x, nil
+}
+
+// dlogSolver solves discrete logarithms modulo the prime p.
+type dlogSolver struct {
+	ctx context.Context
+	p   BigNumber
+	rng *rand.Rand
+}
+
+// primePower returns x mod q^e, where q^e is the factor f of the order of g, by finding the
+// base q digits of x one at a time in the subgroup of order q.
+func (s *dlogSolver) primePower(g, h, order BigNumber, f PrimeFactor) (x, modulus BigNumber, err error) {
+	q := f.Prime
+	generator := g.ModExp(order.DIV(q), s.p)
+	gInverse, _ := g.ModInverse(s.p)
+	modulus = FromUint64(1)
+	cofactor := order
+	for k := 0; k < f.Exponent; k++ {
+		// (h * g^-x)^(order / q^(k+1)) = generator^digit.
+		cofactor = cofactor.DIV(q)
+		t := gInverse.ModExp(x, s.p)
+		t = mulMod(h, t, s.p)
+		target := t.ModExp(cofactor, s.p)
+		digit, err := s.prime(generator, target, q)
+		if err != nil {
+			return BigNumber{}, BigNumber{}, err
+		}
+		x = x.ADD(digit.MUL(modulus))
+		modulus = modulus.MUL(q)
+	}
+	return x, modulus, nil
+}
+
+// prime returns the logarithm of h to the base g, which has the prime order q, given that it exists.
+func (s *dlogSolver) prime(g, h, q BigNumber) (BigNumber, error) {
+	if h.IsOne() {
+		return BigNumber{}, nil
+	}
+	if q.CmpUint64(dlogBSGSLimit) <= 0 {
+		return s.babyStepGiantStep(g, h, q), nil
+	}
+	return s.rho(g, h, q)
+}
+
+// babyStepGiantStep writes x = i*m + j with m = ceil(sqrt(q)), tabulates g^j and then looks up
+// h * g^(-m*i) for increasing i.
+func (s *dlogSolver) babyStepGiantStep(g, h, q BigNumber) BigNumber {
+	m := q.sqrt()
+	m = m.AddUint64(1)
+	steps, _ := m.Uint64()
+	table := make(map[string]uint64, steps)
+	e := FromUint64(1)
+	for j := uint64(0); j < steps; j++ {
+		if _, ok := table[e.GetHex()]; !ok {
+			table[e.GetHex()] = j
+		}
+		e = mulMod(e, g, s.p)
+	}
+	// e is now g^m.
+	giant, _ := e.ModInverse(s.p)
+	gamma := h
+	for i := uint64(0); i < steps; i++ {
+		if j, ok := table[gamma.GetHex()]; ok {
+			x := m.MulUint64(i)
+			return x.AddUint64(j)
+		}
+		gamma = mulMod(gamma, giant, s.p)
+	}
+	panic("bignumbers: discrete logarithm not found in a subgroup that contains it")
+}
+
+// rho finds the logarithm with Pollard's rho: a pseudorandom walk over elements g^a * h^b
+// eventually repeats, and g^a1 * h^b1 = g^a2 * h^b2 gives x = (a2 - a1) / (b1 - b2) mod q.
+// It runs until it succeeds or ctx is done.
+func (s *dlogSolver) rho(g, h, q BigNumber) (BigNumber, error) {
+	type point struct{ x, a, b BigNumber }
+	one := FromUint64(1)
+	step := func(z point) point {
+		low := blockAt(z.x.blocks, 0)
+		switch low.GetDecimal() % 3 {
+		case 0:
+			return point{mulMod(z.x, h, s.p), z.a, addMod(z.b, one, q)}
+		case 1:
+			return point{mulMod(z.x, z.x, s.p), addMod(z.a, z.a, q), addMod(z.b, z.b, q)}
+		default:
+			return point{mulMod(z.x, g, s.p), addMod(z.a, one, q), z.b}
+		}
+	}
+	for {
+		// Start from a random point so that a degenerate collision can be retried.
+		a, b := randomBelow(s.rng, q), randomBelow(s.rng, q)
+		gPower, hPower := g.ModExp(a, s.p), h.ModExp(b, s.p)
+		tortoise := point{mulMod(gPower, hPower, s.p), a, b}
+		hare := tortoise
+		for i := 1; ; i++ {
+			if i%dlogRhoBatch == 0 {
+				if err := s.ctx.Err(); err != nil {
+					return BigNumber{}, err
+				}
+			}
+			tortoise = step(tortoise)
+			hare = step(step(hare))
+			if tortoise.x.Equal(hare.x) {
+				break
+			}
+		}
+		r := subMod(tortoise.b, hare.b, q)
+		if r.IsZero() {
+			continue
+		}
+		inverse, _ := r.ModInverse(q)
+		x := mulMod(subMod(hare.a, tortoise.a, q), inverse, q)
+		if power := g.ModExp(x, s.p); power.Equal(h) {
+			return x, nil
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package bignumbers
+
+import "math/bits"
+
+// The elliptic curve method works on Montgomery curves B*y^2 = x^3 + A*x^2 + x in projective
+// (X : Z) coordinates, where only x is needed and a point multiplication is a Montgomery ladder.
+
+// montgomeryPoint is a point (X : Z) on a Montgomery curve modulo n.
+type montgomeryPoint struct {
+	x, z BigNumber
+}
+
+// montgomeryCurve holds n and (A + 2) / 4 for the curve parameter A.
+type montgomeryCurve struct {
+	n, a24 BigNumber
+}
+
+// double returns 2P.
+func (c *montgomeryCurve) double(p montgomeryPoint) montgomeryPoint {
+	sum := addMod(p.x, p.z, c.n)
+	diff := subMod(p.x, p.z, c.n)
+	sumSquared := mulMod(sum, sum, c.n)
+	diffSquared := mulMod(diff, diff, c.n)
+	t := subMod(sumSquared, diffSquared, c.n)
+	return montgomeryPoint{
+		x: mulMod(sumSquared, diffSquared, c.n),
+		z: mulMod(t, addMod(diffSquared, mulMod(c.a24, t, c.n), c.n), c.n),
+	}
+}
+
+// add returns P + Q given their difference P - Q.
+func (c *montgomeryCurve) add(p, q, diff montgomeryPoint) montgomeryPoint {
+	u := mulMod(subMod(p.x, p.z, c.n), addMod(q.x, q.z, c.n), c.n)
+	v := mulMod(addMod(p.x, p.z, c.n), subMod(q.x, q.z, c.n), c.n)
+	sum := addMod(u, v, c.n)
+	difference := subMod(u, v, c.n)
+	return montgomeryPoint{
+		x: mulMod(diff.z, mulMod(sum, sum, c.n), c.n),
+		z: mulMod(diff.x, mulMod(difference, difference, c.n), c.n),
+	}
+}
+
+// multiply returns k*P with the Montgomery ladder. k must be positive.
+func (c *montgomeryCurve) multiply(p montgomeryPoint, k uint64) montgomeryPoint {
+	r0, r1 := p, c.double(p)
+	for i := 62 - bits.LeadingZeros64(k); i >= 0; i-- {
+		if k>>uint(i)&1 == 1 {
+			r0, r1 = c.add(r1, r0, p), c.double(r1)
+		} else {
+			r1, r0 = c.add(r1, r0, p), c.double(r0)
+		}
+	}
+	return r0
+}
+
+// ecm runs the first stage of the elliptic curve method on one random curve with the given bound.
+func (f *factorizer) ecm(n BigNumber, bound uint64) (BigNumber, bool) {
+	// Suyama's parametrization gives curves whose group order is divisible by 12.
+	sigma := randomBelow(f.rng, n)
+	if sigma.CmpUint64(6) < 0 {
+		sigma = FromUint64(6)
+	}
+	five := FromUint64(5)
+	u := subMod(mulMod(sigma, sigma, n), five.MOD(n), n)
+	v := mulMod(FromUint64(4), sigma, n)
+	uCubed := mulMod(mulMod(u, u, n), u, n)
+	vMinusU := subMod(v, u, n)
+	// a24 = (v - u)^3 * (3u + v) / (16 * u^3 * v)
+	numerator := mulMod(mulMod(mulMod(vMinusU, vMinusU, n), vMinusU, n), addMod(mulMod(FromUint64(3), u, n), v, n), n)
+	denominator := mulMod(mulMod(FromUint64(16), uCubed, n), v, n)
+	inverse, err := denominator.ModInverse(n)
+	if err != nil {
+		g := denominator.GCD(n)
+		return g, !g.IsOne() && !g.Equal(n)
+	}
+	curve := &montgomeryCurve{n: n, a24: mulMod(numerator, inverse, n)}
+	point := montgomeryPoint{x: uCubed, z: mulMod(mulMod(v, v, n), v, n)}
+
+	for i, p := range primesUpTo(bound) {
+		power := p
+		for power <= bound/p {
+			power *= p
+		}
+		point = curve.multiply(point, power)
+		if i%64 == 63 {
+			if point.z.IsZero() {
+				return BigNumber{}, false
+			}
+			if g := point.z.GCD(n); !g.IsOne() {
+				return g, !g.Equal(n)
+			}
+		}
+	}
+	g := point.z.GCD(n)
+	return g, !g.IsOne() && !g.Equal(n)
+}
@@ -0,0 +1,60 @@
+package bignumbers
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNegativeResult is returned when a subtraction would produce a negative number.
+	ErrNegativeResult = errors.New("result is negative")
+	// ErrNegativeValue is returned when a negative native value is converted to a BigNumber.
+	ErrNegativeValue = errors.New("negative value")
+	// ErrNotFinite is returned when NaN or an infinity is converted to a BigNumber.
+	ErrNotFinite = errors.New("value is not finite")
+	// ErrDivisionByZero is not returned but is the panic value of MOD, DIV, DivMod, DivModUint64,
+	// ModExp and POWMOD when the divisor or modulus is zero. Recover it with
+	//
+	//	defer func() {
+	//		if r := recover(); r != nil {
+	//			if err, ok := r.(error); ok && errors.Is(err, bignumbers.ErrDivisionByZero) {
+	//				// handle the division by zero
+	//			}
+	//		}
+	//	}()
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrNotInvertible is returned when a number has no inverse modulo the given modulus.
+	ErrNotInvertible = errors.New("not invertible")
+	// ErrNoSolution is returned when an equation or a system of congruences has no solution.
+	ErrNoSolution = errors.New("no solution")
+	// ErrDomain is returned when an argument is outside the domain of a function, such as an even
+	// modulus for the Jacobi symbol.
+	ErrDomain = errors.New("argument out of domain")
+	// ErrInvalidDigit is returned when a string contains a character that is not a digit in its base.
+	ErrInvalidDigit = errors.New("invalid digit")
+	// ErrInvalidSeparator is returned when a '_' digit separator does not sit between two digits.
+	ErrInvalidSeparator = errors.New("'_' must separate successive digits")
+	// ErrTooManyDigits is returned when a string has more digits than fit into a Uint.
+	ErrTooManyDigits = errors.New("too many digits")
+)
+
+// ParseError records a failure to parse a number string.
+// Err is one of ErrInvalidDigit, ErrInvalidSeparator or ErrTooManyDigits.
+type ParseError struct {
+	Input  string // the string being parsed, including any prefix
+	Offset int    // byte offset of the offending character in Input
+	Base   int    // 2, 8, 10 or 16
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	reason := e.Err.Error()
+	if errors.Is(e.Err, ErrInvalidDigit) && e.Offset < len(e.Input) {
+		reason = fmt.Sprintf("%q is not a base %d digit", e.Input[e.Offset], e.Base)
+	}
+	return fmt.Sprintf("parsing %q: offset %d: %s", e.Input, e.Offset, reason)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
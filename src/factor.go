@@ -0,0 +1,285 @@
+package bignumbers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// FactorMethod identifies the algorithm that found a prime factor.
+type FactorMethod int
+
+const (
+	// MethodTrialDivision marks factors found by dividing by the small primes.
+	MethodTrialDivision FactorMethod = iota
+	// MethodPollardRho marks factors split off by Pollard's rho in Brent's variant.
+	MethodPollardRho
+	// MethodPollardPM1 marks factors split off by Pollard's p-1.
+	MethodPollardPM1
+	// MethodECM marks factors split off by Lenstra's elliptic curve method.
+	MethodECM
+	// MethodPrimalityTest marks a cofactor that was left over and found to be prime without being split.
+	MethodPrimalityTest
+	// MethodQuadraticSieve marks factors split off by the self-initialising quadratic sieve.
+	MethodQuadraticSieve
+)
+
+func (m FactorMethod) String() string {
+	switch m {
+	case MethodTrialDivision:
+		return "trial division"
+	case MethodPollardRho:
+		return "Pollard rho"
+	case MethodPollardPM1:
+		return "Pollard p-1"
+	case MethodECM:
+		return "ECM"
+	case MethodPrimalityTest:
+		return "primality test"
+	case MethodQuadraticSieve:
+		return "quadratic sieve"
+	}
+	return fmt.Sprintf("FactorMethod(%d)", int(m))
+}
+
+// PrimeFactor is a prime factor together with its multiplicity.
+type PrimeFactor struct {
+	Prime    BigNumber
+	Exponent int
+	// Method is the algorithm that found the prime. If the same prime was found more than once,
+	// it is the method that found it first.
+	Method FactorMethod
+}
+
+// primalityRounds is the number of random Miller-Rabin rounds used to accept a factor as prime.
+const primalityRounds = 20
+
+// Factor returns the prime factorization of n in increasing order of the primes.
+// It combines trial division, Pollard's rho, Pollard's p-1, the elliptic curve method and the
+// quadratic sieve and keeps running until n is factored or ctx is done, in which case ctx.Err()
+// is returned.
+// The factorization of 1 is empty; 0 has none and returns an error.
+func Factor(ctx context.Context, n BigNumber) ([]PrimeFactor, error) {
+	if n.IsZero() {
+		return nil, fmt.Errorf("factor: zero has no prime factorization")
+	}
+	f := &factorizer{ctx: ctx, rng: rand.New(rand.NewSource(1))}
+	remaining := n.Clone()
+	// If the trial division bound passes the square root of what is left, the rest is prime.
+	leftover := MethodPrimalityTest
+	for _, p := range smallPrimeTable() {
+		if remaining.CmpUint64(p*p) < 0 {
+			leftover = MethodTrialDivision
+			break
+		}
+		for {
+			quotient, r := remaining.DivModUint64(p)
+			if r != 0 {
+				break
+			}
+			f.add(FromUint64(p), MethodTrialDivision)
+			remaining = quotient
+		}
+	}
+	if err := f.split(remaining, leftover); err != nil {
+		return nil, err
+	}
+	return f.factors(), nil
+}
+
+// factorizer collects the prime factors found while splitting a number.
+type factorizer struct {
+	ctx    context.Context
+	rng    *rand.Rand
+	primes []PrimeFactor
+}
+
+// add records one occurrence of the prime p.
+func (f *factorizer) add(p BigNumber, method FactorMethod) {
+	for i := range f.primes {
+		if f.primes[i].Prime.Equal(p) {
+			f.primes[i].Exponent++
+			return
+		}
+	}
+	f.primes = append(f.primes, PrimeFactor{Prime: p, Exponent: 1, Method: method})
+}
+
+// factors returns the collected factors sorted by prime.
+func (f *factorizer) factors() []PrimeFactor {
+	sort.Slice(f.primes, func(i, j int) bool {
+		return f.primes[i].Prime.LessThan(f.primes[j].Prime)
+	})
+	return f.primes
+}
+
+// split records the prime factors of n, which has no factors below the trial division bound.
+// method is credited for n itself if it turns out to be prime.
+func (f *factorizer) split(n BigNumber, method FactorMethod) error {
+	if n.CmpUint64(1) <= 0 {
+		return nil
+	}
+	if n.ProbablyPrime(primalityRounds) {
+		f.add(n, method)
+		return nil
+	}
+	d, method, err := f.findFactor(n)
+	if err != nil {
+		return err
+	}
+	if err := f.split(d, method); err != nil {
+		return err
+	}
+	return f.split(n.DIV(d), method)
+}
+
+// qsMinBits and qsECMBits select the quadratic sieve for cofactors with at least qsMinBits bits,
+// after a short run of ECM from qsECMBits bits on, which finds small factors faster.
+const (
+	qsMinBits = 100
+	qsECMBits = 160
+)
+
+// findFactor returns a non-trivial factor of the composite n and the method that found it.
+// Cheap methods run first. Numbers below qsMinBits bits then go to ECM with growing bounds and
+// larger ones to the quadratic sieve, both of which run until they succeed or ctx is done.
+func (f *factorizer) findFactor(n BigNumber) (BigNumber, FactorMethod, error) {
+	if d, ok := f.pollardPM1(n, 10000); ok {
+		return d, MethodPollardPM1, nil
+	}
+	for c := uint64(1); c <= 3; c++ {
+		d, ok, err := f.pollardRho(n, FromUint64(c), 1<<16)
+		if err != nil {
+			return BigNumber{}, 0, err
+		}
+		if ok {
+			return d, MethodPollardRho, nil
+		}
+	}
+	if n.BitLen() >= qsMinBits {
+		if n.BitLen() >= qsECMBits {
+			for i := 0; i < 25; i++ {
+				if err := f.ctx.Err(); err != nil {
+					return BigNumber{}, 0, err
+				}
+				if d, ok := f.ecm(n, 2000); ok {
+					return d, MethodECM, nil
+				}
+			}
+		}
+		d, err := siqs(f.ctx, n, f.rng)
+		if err != nil {
+			return BigNumber{}, 0, err
+		}
+		return d, MethodQuadraticSieve, nil
+	}
+	for bound, curves := uint64(2000), 25; ; bound, curves = bound*4, curves*3 {
+		for i := 0; i < curves; i++ {
+			if err := f.ctx.Err(); err != nil {
+				return BigNumber{}, 0, err
+			}
+			if d, ok := f.ecm(n, bound); ok {
+				return d, MethodECM, nil
+			}
+		}
+	}
+}
+
+// pollardRho looks for a factor of n with Brent's variant of Pollard's rho using x^2 + c.
+// It gives up after about maxIterations steps.
+func (f *factorizer) pollardRho(n, c BigNumber, maxIterations int) (BigNumber, bool, error) {
+	const batch = 128
+	next := func(x BigNumber) BigNumber {
+		return addMod(mulMod(x, x, n), c, n)
+	}
+	y := randomBelow(f.rng, n)
+	g, q := FromUint64(1), FromUint64(1)
+	var x, ys BigNumber
+	for r := 1; g.IsOne(); r *= 2 {
+		if r > maxIterations {
+			return BigNumber{}, false, nil
+		}
+		if err := f.ctx.Err(); err != nil {
+			return BigNumber{}, false, err
+		}
+		x = y
+		for i := 0; i < r; i++ {
+			y = next(y)
+		}
+		for k := 0; k < r && g.IsOne(); k += batch {
+			ys = y
+			for i := 0; i < min(batch, r-k); i++ {
+				y = next(y)
+				q = mulMod(q, absDiff(x, y), n)
+			}
+			g = q.GCD(n)
+		}
+	}
+	if g.Equal(n) {
+		// The batch overshot; retrace it one step at a time.
+		for g.IsOne() || g.Equal(n) {
+			ys = next(ys)
+			diff := absDiff(x, ys)
+			g = diff.GCD(n)
+			if g.Equal(n) {
+				return BigNumber{}, false, nil
+			}
+		}
+	}
+	return g, true, nil
+}
+
+// pollardPM1 runs the first stage of Pollard's p-1 with the given smoothness bound.
+func (f *factorizer) pollardPM1(n BigNumber, bound uint64) (BigNumber, bool) {
+	a := FromUint64(2)
+	for i, p := range primesUpTo(bound) {
+		power := p
+		for power <= bound/p {
+			power *= p
+		}
+		a = a.POWMOD(n, power)
+		if i%64 == 63 {
+			if d, ok := nonTrivialFactor(a, n); ok {
+				return d, true
+			}
+			if a.IsOne() {
+				return BigNumber{}, false
+			}
+		}
+	}
+	return nonTrivialFactor(a, n)
+}
+
+// nonTrivialFactor returns gcd(a - 1, n) if it is a proper factor of n.
+func nonTrivialFactor(a, n BigNumber) (BigNumber, bool) {
+	if a.IsZero() {
+		return BigNumber{}, false
+	}
+	aMinusOne, _ := a.SubUint64(1)
+	g := aMinusOne.GCD(n)
+	if g.IsOne() || g.Equal(n) {
+		return BigNumber{}, false
+	}
+	return g, true
+}
+
+// primesUpTo returns the primes not greater than bound.
+func primesUpTo(bound uint64) []uint64 {
+	if bound >= smallPrimeLimit {
+		return sievePrimes(bound + 1)
+	}
+	primes := smallPrimeTable()
+	i := sort.Search(len(primes), func(i int) bool { return primes[i] > bound })
+	return primes[:i]
+}
+
+// absDiff returns |a - b|.
+func absDiff(a, b BigNumber) BigNumber {
+	if a.LessThan(b) {
+		diff, _ := b.SUB(a)
+		return diff
+	}
+	diff, _ := a.SUB(b)
+	return diff
+}
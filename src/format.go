@@ -0,0 +1,196 @@
+package bignumbers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format implements fmt.Formatter. It supports the same verbs and flags as *big.Int:
+// 'b', 'o', 'O', 'd', 'x', 'X', 's' and 'v', together with width, precision and the '+', ' ', '-', '0' and '#' flags.
+func (bn BigNumber) Format(s fmt.State, ch rune) {
+	var digits string
+	switch ch {
+	case 'b':
+		digits = bn.GetBinary()
+	case 'o', 'O':
+		digits = bn.getOctal()
+	case 'd', 's', 'v':
+		digits = bn.GetDecimal()
+	case 'x':
+		digits = bn.GetHex()
+	case 'X':
+		digits = strings.ToUpper(bn.GetHex())
+	default:
+		fmt.Fprintf(s, "%%!%c(bignumbers.BigNumber=%d)", ch, bn)
+		return
+	}
+	if digits == "" {
+		digits = "0"
+	}
+
+	sign := ""
+	switch {
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	prefix := ""
+	if s.Flag('#') {
+		switch ch {
+		case 'b':
+			prefix = "0b"
+		case 'o':
+			prefix = "0"
+		case 'x':
+			prefix = "0x"
+		case 'X':
+			prefix = "0X"
+		}
+	}
+	if ch == 'O' {
+		prefix = "0o"
+	}
+
+	var left, zeros, right int
+	precision, precisionSet := s.Precision()
+	if precisionSet {
+		switch {
+		case len(digits) < precision:
+			zeros = precision - len(digits)
+		case digits == "0" && precision == 0:
+			// print nothing if the value is zero and the precision is zero
+			return
+		}
+	}
+
+	length := len(sign) + len(prefix) + zeros + len(digits)
+	if width, widthSet := s.Width(); widthSet && length < width {
+		switch padding := width - length; {
+		case s.Flag('-'):
+			right = padding
+		case s.Flag('0') && !precisionSet:
+			zeros = padding
+		default:
+			left = padding
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.Repeat(" ", left))
+	sb.WriteString(sign)
+	sb.WriteString(prefix)
+	sb.WriteString(strings.Repeat("0", zeros))
+	sb.WriteString(digits)
+	sb.WriteString(strings.Repeat(" ", right))
+	fmt.Fprint(s, sb.String())
+}
+
+// String returns the decimal representation of the BigNumber.
+func (bn BigNumber) String() string {
+	return fmt.Sprintf("%d", bn)
+}
+
+// Scan implements fmt.Scanner. The verbs 'b', 'o', 'd', 'x' and 'X' select the base;
+// 's' and 'v' detect it from a 0b, 0o, 0x or 0 prefix and default to decimal.
+// An explicit prefix matching the verb's base is accepted as well.
+func (bn *BigNumber) Scan(state fmt.ScanState, verb rune) error {
+	var base int
+	switch verb {
+	case 'b':
+		base = 2
+	case 'o':
+		base = 8
+	case 'd':
+		base = 10
+	case 'x', 'X':
+		base = 16
+	case 's', 'v':
+		base = 0
+	default:
+		return fmt.Errorf("bad verb '%%%c' for BigNumber", verb)
+	}
+
+	state.SkipSpace()
+	if r, _, err := state.ReadRune(); err == nil && r != '+' {
+		state.UnreadRune()
+	}
+	token, err := state.Token(false, func(r rune) bool {
+		return r == '_' || (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+	})
+	if err != nil {
+		return err
+	}
+	value := strings.ToLower(string(token))
+	if value == "" {
+		return fmt.Errorf("expected a BigNumber")
+	}
+
+	prefixBase := 0
+	switch {
+	case strings.HasPrefix(value, "0x"):
+		prefixBase = 16
+	case strings.HasPrefix(value, "0b"):
+		prefixBase = 2
+	case strings.HasPrefix(value, "0o"):
+		prefixBase = 8
+	case len(value) > 1 && value[0] == '0' && base == 0:
+		prefixBase = 8
+		value = "0o" + value[1:]
+	}
+	if prefixBase != 0 && (base == 0 || base == prefixBase) {
+		base = prefixBase
+		value = value[2:]
+	}
+	if base == 0 {
+		base = 10
+	}
+
+	var result BigNumber
+	if err := result.setBase(value, base); err != nil {
+		return err
+	}
+	bn.setBlocks(result.blocks)
+	return nil
+}
+
+// setBase sets the value of the BigNumber from a string of digits in base 2, 8, 10 or 16.
+func (bn *BigNumber) setBase(digits string, base int) error {
+	if digits == "" {
+		return fmt.Errorf("empty base %d number", base)
+	}
+	var err error
+	switch base {
+	case 2:
+		err = bn.SetBinary(digits)
+	case 10:
+		err = bn.SetDecimal(digits)
+	case 16:
+		err = bn.SetHex(digits)
+	case 8:
+		digits, err = parseDigits(digits, 8, "", 0)
+		if err != nil {
+			return err
+		}
+		blocks := make([]Uint, 0)
+		for _, digit := range digits {
+			blocks = mulAddBlocks(blocks, 8, uint64(digit-'0'))
+		}
+		bn.setBlocks(blocks)
+	default:
+		return fmt.Errorf("unsupported base %d", base)
+	}
+	return err
+}
+
+// getOctal returns the octal representation of the BigNumber.
+func (bn *BigNumber) getOctal() string {
+	binary := bn.GetBinary()
+	binary = AddLeadingZeros(binary, (len(binary)+2)/3*3)
+	var sb strings.Builder
+	for i := 0; i < len(binary); i += 3 {
+		sb.WriteByte('0' + (binary[i]-'0')<<2 | (binary[i+1]-'0')<<1 | (binary[i+2] - '0'))
+	}
+	return sb.String()
+}
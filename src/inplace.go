@@ -0,0 +1,161 @@
+package bignumbers
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// The methods in this file follow the math/big convention: the receiver is the destination,
+// the operands are passed as arguments and the receiver is returned to allow chaining.
+// The receiver's block capacity is reused, so a loop that keeps updating the same BigNumber
+// does not allocate once the capacity is large enough. The receiver may alias any operand.
+//
+// Since the blocks are updated in place, the receiver must not share its blocks with
+// another BigNumber (e.g. a struct copy). Use Clone to obtain an independent copy first.
+
+// resize returns the receiver's blocks resized to n, reusing the existing capacity when possible.
+// The contents of the returned slice are unspecified.
+func (bn *BigNumber) resize(n int) []Uint {
+	if n <= cap(bn.blocks) {
+		return bn.blocks[:n]
+	}
+	return make([]Uint, n, n+n/4+1)
+}
+
+// Set sets bn to x and returns bn.
+func (bn *BigNumber) Set(x *BigNumber) *BigNumber {
+	if bn != x {
+		blocks := bn.resize(len(x.blocks))
+		copy(blocks, x.blocks)
+		bn.blocks = norm(blocks)
+	}
+	return bn
+}
+
+// Add sets bn to the sum x+y and returns bn.
+func (bn *BigNumber) Add(x, y *BigNumber) *BigNumber {
+	xBlocks, yBlocks := x.blocks, y.blocks
+	if len(xBlocks) < len(yBlocks) {
+		xBlocks, yBlocks = yBlocks, xBlocks
+	}
+	blocks := bn.resize(len(xBlocks) + 1)
+	var carry uint64
+	for i := range xBlocks {
+		var other uint64
+		if i < len(yBlocks) {
+			other = yBlocks[i].GetDecimal()
+		}
+		var sum uint64
+		sum, carry = bits.Add64(xBlocks[i].GetDecimal(), other, carry)
+		blocks[i] = Uint{sum}
+	}
+	blocks[len(xBlocks)] = Uint{carry}
+	bn.blocks = norm(blocks)
+	return bn
+}
+
+// Sub sets bn to the difference x-y and returns bn.
+// If the difference is negative, bn is left unchanged and an error is returned.
+func (bn *BigNumber) Sub(x, y *BigNumber) (*BigNumber, error) {
+	if x.LessThan(*y) {
+		return bn, fmt.Errorf("sub %w", ErrNegativeResult)
+	}
+	xBlocks, yBlocks := x.blocks, y.blocks
+	blocks := bn.resize(len(xBlocks))
+	var borrow uint64
+	for i := range xBlocks {
+		var other uint64
+		if i < len(yBlocks) {
+			other = yBlocks[i].GetDecimal()
+		}
+		var diff uint64
+		diff, borrow = bits.Sub64(xBlocks[i].GetDecimal(), other, borrow)
+		blocks[i] = Uint{diff}
+	}
+	bn.blocks = norm(blocks)
+	return bn, nil
+}
+
+// Xor sets bn to the bitwise exclusive or x^y and returns bn.
+func (bn *BigNumber) Xor(x, y *BigNumber) *BigNumber {
+	return bn.bitwise(x, y, func(a, b uint64) uint64 { return a ^ b })
+}
+
+// And sets bn to the bitwise and x&y and returns bn.
+func (bn *BigNumber) And(x, y *BigNumber) *BigNumber {
+	return bn.bitwise(x, y, func(a, b uint64) uint64 { return a & b })
+}
+
+// Or sets bn to the bitwise or x|y and returns bn.
+func (bn *BigNumber) Or(x, y *BigNumber) *BigNumber {
+	return bn.bitwise(x, y, func(a, b uint64) uint64 { return a | b })
+}
+
+// bitwise sets bn to the result of applying operation to the blocks of x and y and returns bn.
+func (bn *BigNumber) bitwise(x, y *BigNumber, operation func(a, b uint64) uint64) *BigNumber {
+	xBlocks, yBlocks := x.blocks, y.blocks
+	if len(xBlocks) < len(yBlocks) {
+		xBlocks, yBlocks = yBlocks, xBlocks
+	}
+	blocks := bn.resize(len(xBlocks))
+	for i := range xBlocks {
+		var other uint64
+		if i < len(yBlocks) {
+			other = yBlocks[i].GetDecimal()
+		}
+		blocks[i] = Uint{operation(xBlocks[i].GetDecimal(), other)}
+	}
+	bn.blocks = norm(blocks)
+	return bn
+}
+
+// Lsh sets bn to x shifted to the left by n bits and returns bn.
+func (bn *BigNumber) Lsh(x *BigNumber, n uint) *BigNumber {
+	xBlocks := x.blocks
+	if len(xBlocks) == 0 {
+		bn.blocks = bn.blocks[:0]
+		return bn
+	}
+	blockShift, bitShift := int(n/blockBits), n%blockBits
+	blocks := bn.resize(len(xBlocks) + blockShift + 1)
+	// Going from the most significant block down keeps the aliased x intact until it is read.
+	high := uint64(0)
+	for i := len(xBlocks) - 1; i >= 0; i-- {
+		value := xBlocks[i].GetDecimal()
+		if bitShift > 0 {
+			blocks[i+blockShift+1] = Uint{high | value>>(blockBits-bitShift)}
+			high = value << bitShift
+		} else {
+			blocks[i+blockShift+1] = Uint{high}
+			high = value
+		}
+	}
+	blocks[blockShift] = Uint{high}
+	for i := 0; i < blockShift; i++ {
+		blocks[i] = Uint{0}
+	}
+	bn.blocks = norm(blocks)
+	return bn
+}
+
+// Rsh sets bn to x shifted to the right by n bits and returns bn.
+func (bn *BigNumber) Rsh(x *BigNumber, n uint) *BigNumber {
+	xBlocks := x.blocks
+	blockShift, bitShift := int(n/blockBits), n%blockBits
+	if blockShift >= len(xBlocks) {
+		bn.blocks = bn.blocks[:0]
+		return bn
+	}
+	length := len(xBlocks) - blockShift
+	blocks := bn.resize(length)
+	// Going from the least significant block up keeps the aliased x intact until it is read.
+	for i := 0; i < length; i++ {
+		value := xBlocks[i+blockShift].GetDecimal() >> bitShift
+		if bitShift > 0 && i+blockShift+1 < len(xBlocks) {
+			value |= xBlocks[i+blockShift+1].GetDecimal() << (blockBits - bitShift)
+		}
+		blocks[i] = Uint{value}
+	}
+	bn.blocks = norm(blocks)
+	return bn
+}
@@ -0,0 +1,129 @@
+package bignumbers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// SetBytes sets the value of the BigNumber from a big-endian byte slice.
+func (bn *BigNumber) SetBytes(buf []byte) {
+	blocks := make([]Uint, (len(buf)+7)/8)
+	for i := range blocks {
+		end := len(buf) - i*8
+		start := end - 8
+		if start < 0 {
+			start = 0
+		}
+		var value uint64
+		for _, b := range buf[start:end] {
+			value = value<<8 | uint64(b)
+		}
+		blocks[i] = Uint{value}
+	}
+	bn.setBlocks(blocks)
+}
+
+// GetBytes returns the minimal big-endian byte representation of the BigNumber.
+// Zero is represented by an empty slice.
+func (bn *BigNumber) GetBytes() []byte {
+	blocks := bn.blocks
+	buf := make([]byte, len(blocks)*8)
+	for i, block := range blocks {
+		binary.BigEndian.PutUint64(buf[len(buf)-(i+1)*8:], block.GetDecimal())
+	}
+	return bytes.TrimLeft(buf, "\x00")
+}
+
+// MarshalText implements encoding.TextMarshaler. The value is encoded as a 0x-prefixed hex string.
+func (bn BigNumber) MarshalText() ([]byte, error) {
+	hex := bn.GetHex()
+	if hex == "" {
+		hex = "0"
+	}
+	return []byte("0x" + hex), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts 0x-prefixed hex, 0b-prefixed binary and plain decimal strings. A prefix must be
+// followed by at least one digit.
+func (bn *BigNumber) UnmarshalText(text []byte) error {
+	value := string(text)
+	if value == "" {
+		return fmt.Errorf("cannot unmarshal empty string into BigNumber")
+	}
+	var result BigNumber
+	var err error
+	switch {
+	case strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X"):
+		err = result.SetHex(value)
+		if len(value) == 2 {
+			err = &ParseError{Input: value, Offset: 2, Base: 16, Err: ErrInvalidDigit}
+		}
+	case strings.HasPrefix(value, "0b") || strings.HasPrefix(value, "0B"):
+		err = result.SetBinary(value)
+		if len(value) == 2 {
+			err = &ParseError{Input: value, Offset: 2, Base: 2, Err: ErrInvalidDigit}
+		}
+	default:
+		err = result.SetDecimal(value)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal %q into BigNumber: %w", value, err)
+	}
+	bn.setBlocks(result.blocks)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The value is encoded as a quoted 0x-prefixed hex string.
+func (bn BigNumber) MarshalJSON() ([]byte, error) {
+	text, err := bn.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(`"` + string(text) + `"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts quoted strings in any format supported by UnmarshalText and bare non-negative integers of any size.
+// A JSON null leaves the BigNumber unchanged.
+func (bn *BigNumber) UnmarshalJSON(data []byte) error {
+	value := string(bytes.TrimSpace(data))
+	if value == "null" {
+		return nil
+	}
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		return bn.UnmarshalText([]byte(value[1 : len(value)-1]))
+	}
+	if value == "" {
+		return fmt.Errorf("cannot unmarshal empty JSON value into BigNumber")
+	}
+	var result BigNumber
+	if err := result.SetDecimal(value); err != nil {
+		return fmt.Errorf("cannot unmarshal JSON number %s into BigNumber: %w", value, err)
+	}
+	bn.setBlocks(result.blocks)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The value is encoded as a uvarint byte length followed by the minimal big-endian magnitude.
+func (bn BigNumber) MarshalBinary() ([]byte, error) {
+	magnitude := bn.GetBytes()
+	buf := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(magnitude)), uint64(len(magnitude)))
+	return append(buf, magnitude...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (bn *BigNumber) UnmarshalBinary(data []byte) error {
+	length, n := binary.Uvarint(data)
+	if n <= 0 {
+		return fmt.Errorf("invalid BigNumber length prefix")
+	}
+	if uint64(len(data)-n) != length {
+		return fmt.Errorf("BigNumber length prefix is %d but %d bytes follow", length, len(data)-n)
+	}
+	bn.SetBytes(data[n:])
+	return nil
+}
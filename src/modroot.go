@@ -0,0 +1,295 @@
+package bignumbers
+
+import (
+	"fmt"
+)
+
+// ModSqrt returns a square root of a modulo the prime p: the smaller of the two roots r and p-r
+// with r^2 ≡ a (mod p). It uses Tonelli-Shanks, or Cipolla's algorithm when p-1 is divisible by
+// a large power of two. The returned error wraps ErrNoSolution if a is not a quadratic residue and
+// ErrDomain if p is not prime.
+func ModSqrt(a, p BigNumber) (BigNumber, error) {
+	if !p.ProbablyPrime(primalityRounds) {
+		return BigNumber{}, fmt.Errorf("square root modulo %s: %w", p.GetDecimal(), ErrDomain)
+	}
+	a = a.MOD(p)
+	if p.CmpUint64(2) == 0 || a.IsZero() {
+		return a, nil
+	}
+	if jacobi(a, p) != 1 {
+		return BigNumber{}, fmt.Errorf("square root of %s modulo %s: %w", a.GetDecimal(), p.GetDecimal(), ErrNoSolution)
+	}
+	r := sqrtModPrime(a, p)
+	if other, _ := p.SUB(r); other.LessThan(r) {
+		return other, nil
+	}
+	return r, nil
+}
+
+// sqrtModPrime returns a square root of the quadratic residue a modulo the odd prime p.
+func sqrtModPrime(a, p BigNumber) BigNumber {
+	pMinusOne, _ := p.SubUint64(1)
+	s := pMinusOne.TrailingZeros()
+	if s == 1 {
+		// p ≡ 3 (mod 4): a^((p+1)/4) is a root.
+		e := p.AddUint64(1)
+		return a.ModExp(e.ShiftR(2), p)
+	}
+	// Tonelli-Shanks takes up to s^2 multiplications on top of one exponentiation, Cipolla about
+	// four times the multiplications of an exponentiation.
+	if s*s > 3*p.BitLen() {
+		return cipolla(a, p)
+	}
+	return tonelliShanks(a, p, s)
+}
+
+// tonelliShanks returns a square root of the quadratic residue a modulo the odd prime p, where
+// p - 1 = q * 2^s with q odd.
+func tonelliShanks(a, p BigNumber, s int) BigNumber {
+	pMinusOne, _ := p.SubUint64(1)
+	q := pMinusOne.ShiftR(s)
+	z := nonResidue(p, 2)
+	c := z.ModExp(q, p)
+	t := a.ModExp(q, p)
+	qPlusOne := q.AddUint64(1)
+	r := a.ModExp(qPlusOne.ShiftR(1), p)
+	// Invariant: r^2 = a*t, where t has order 2^i for some i < m.
+	for m := s; !t.IsOne(); {
+		i, t2 := 0, t
+		for !t2.IsOne() {
+			t2 = mulMod(t2, t2, p)
+			i++
+		}
+		b := c
+		for j := 0; j < m-i-1; j++ {
+			b = mulMod(b, b, p)
+		}
+		m, c = i, mulMod(b, b, p)
+		t, r = mulMod(t, c, p), mulMod(r, b, p)
+	}
+	return r
+}
+
+// cipolla returns a square root of the quadratic residue a modulo the odd prime p by computing
+// (t + w)^((p+1)/2) in GF(p^2) = GF(p)[w] with w^2 = t^2 - a a non-residue.
+func cipolla(a, p BigNumber) BigNumber {
+	var t, w2 BigNumber
+	for t = FromUint64(1); ; t = t.AddUint64(1) {
+		w2 = subMod(mulMod(t, t, p), a, p)
+		if jacobi(w2, p) == -1 {
+			break
+		}
+	}
+	// (x0 + x1*w) * (y0 + y1*w) = (x0*y0 + x1*y1*w^2) + (x0*y1 + x1*y0)*w
+	multiply := func(x0, x1, y0, y1 BigNumber) (BigNumber, BigNumber) {
+		return addMod(mulMod(x0, y0, p), mulMod(mulMod(x1, y1, p), w2, p), p),
+			addMod(mulMod(x0, y1, p), mulMod(x1, y0, p), p)
+	}
+	e := p.AddUint64(1)
+	e = e.ShiftR(1)
+	r0, r1 := FromUint64(1), BigNumber{}
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r0, r1 = multiply(r0, r1, r0, r1)
+		if e.Bit(i) == 1 {
+			r0, r1 = multiply(r0, r1, t, FromUint64(1))
+		}
+	}
+	return r0
+}
+
+// nonResidue returns the smallest z >= 2 that is not a k-th power residue modulo the prime p,
+// where k is 2 or 3 and divides p - 1.
+func nonResidue(p BigNumber, k uint64) BigNumber {
+	pMinusOne, _ := p.SubUint64(1)
+	e, _ := pMinusOne.DivModUint64(k)
+	for z := FromUint64(2); ; z = z.AddUint64(1) {
+		if k == 2 {
+			if jacobi(z, p) == -1 {
+				return z
+			}
+		} else if power := z.ModExp(e, p); !power.IsOne() {
+			return z
+		}
+	}
+}
+
+// ModSqrtPrimePower returns a square root of a modulo p^k for a prime p and k >= 1. A root modulo
+// p is lifted with Hensel's lemma; powers of two are lifted one bit at a time. If p divides a the
+// root is built from a root of a / p^(2j). The returned error wraps ErrNoSolution if there is no
+// root and ErrDomain if p is not prime or k < 1.
+func ModSqrtPrimePower(a, p BigNumber, k int) (BigNumber, error) {
+	if k < 1 || !p.ProbablyPrime(primalityRounds) {
+		return BigNumber{}, fmt.Errorf("square root modulo %s^%d: %w", p.GetDecimal(), k, ErrDomain)
+	}
+	modulus := p.pow(uint64(k))
+	a = a.MOD(modulus)
+	if a.IsZero() {
+		return BigNumber{}, nil
+	}
+	// a = p^v * u with u coprime to p. A root exists only for even v, and then it is p^(v/2)
+	// times a root of u modulo p^(k-v).
+	u, v := a, 0
+	for {
+		quotient, remainder := u.DivMod(p)
+		if !remainder.IsZero() {
+			break
+		}
+		u = quotient
+		v++
+	}
+	if v%2 == 1 {
+		return BigNumber{}, fmt.Errorf("square root of %s modulo %s^%d: %w", a.GetDecimal(), p.GetDecimal(), k, ErrNoSolution)
+	}
+	var r BigNumber
+	var err error
+	if p.CmpUint64(2) == 0 {
+		r, err = sqrtModPowerOfTwo(u, k-v)
+	} else {
+		r, err = sqrtModOddPrimePower(u, p, k-v)
+	}
+	if err != nil {
+		return BigNumber{}, fmt.Errorf("square root modulo %s^%d: %w", p.GetDecimal(), k, err)
+	}
+	scale := p.pow(uint64(v / 2))
+	return mulMod(r, scale, modulus), nil
+}
+
+// sqrtModOddPrimePower returns a square root of a modulo p^k, where a is coprime to the odd prime p.
+func sqrtModOddPrimePower(a, p BigNumber, k int) (BigNumber, error) {
+	r, err := ModSqrt(a, p)
+	if err != nil {
+		return BigNumber{}, err
+	}
+	// Newton's step r - (r^2 - a) / (2r) doubles the number of correct base p digits.
+	for precision := 1; precision < k; {
+		precision = min(2*precision, k)
+		modulus := p.pow(uint64(precision))
+		twice := r.MulUint64(2)
+		inverse, err := twice.ModInverse(modulus)
+		if err != nil {
+			return BigNumber{}, err
+		}
+		excess := subMod(mulMod(r, r, modulus), a.MOD(modulus), modulus)
+		r = subMod(r.MOD(modulus), mulMod(excess, inverse, modulus), modulus)
+	}
+	return r, nil
+}
+
+// sqrtModPowerOfTwo returns a square root of the odd a modulo 2^k.
+func sqrtModPowerOfTwo(a BigNumber, k int) (BigNumber, error) {
+	// Odd squares are 1 modulo 2, modulo 4 and modulo 8.
+	if mask := uint64(1)<<min(k, 3) - 1; a.low()&mask != 1 {
+		return BigNumber{}, ErrNoSolution
+	}
+	// If r^2 ≡ a (mod 2^i) but not modulo 2^(i+1), then (r + 2^(i-1))^2 ≡ a (mod 2^(i+1)) for i >= 3.
+	r := FromUint64(1)
+	for i := 3; i < k; i++ {
+		square := r.MUL(r)
+		if square.Bit(i) != a.Bit(i) {
+			var step BigNumber
+			step.SetBit(i-1, 1)
+			r = r.ADD(step)
+		}
+	}
+	return r, nil
+}
+
+// ModSqrtComposite returns a square root of a modulo the number with the given prime
+// factorization, combining roots modulo every prime power with the Chinese Remainder Theorem.
+// A composite modulus has several roots; the one returned is the combination of the roots chosen
+// by ModSqrtPrimePower. The returned error wraps ErrNoSolution if a has no root modulo one of the
+// prime powers.
+func ModSqrtComposite(a BigNumber, factors []PrimeFactor) (BigNumber, error) {
+	residues := make([]BigNumber, len(factors))
+	moduli := make([]BigNumber, len(factors))
+	for i, f := range factors {
+		r, err := ModSqrtPrimePower(a, f.Prime, f.Exponent)
+		if err != nil {
+			return BigNumber{}, err
+		}
+		residues[i], moduli[i] = r, f.Prime.pow(uint64(f.Exponent))
+	}
+	x, _, err := CRT(residues, moduli)
+	if err != nil {
+		return BigNumber{}, fmt.Errorf("square root: %w", err)
+	}
+	return x, nil
+}
+
+// ModCbrt returns a cube root of a modulo the prime p. For p ≡ 2 (mod 3) every residue has a
+// unique cube root; for p ≡ 1 (mod 3) the Adleman-Manders-Miller algorithm finds one of the three.
+// The returned error wraps ErrNoSolution if a is not a cubic residue and ErrDomain if p is not prime.
+func ModCbrt(a, p BigNumber) (BigNumber, error) {
+	if !p.ProbablyPrime(primalityRounds) {
+		return BigNumber{}, fmt.Errorf("cube root modulo %s: %w", p.GetDecimal(), ErrDomain)
+	}
+	a = a.MOD(p)
+	_, pMod3 := p.DivModUint64(3)
+	if a.IsZero() || pMod3 == 0 {
+		// Modulo 3 every residue is its own cube.
+		return a, nil
+	}
+	pMinusOne, _ := p.SubUint64(1)
+	if pMod3 == 2 {
+		// Cubing permutes the group of order p - 1, and its inverse is the power 1/3 mod (p - 1).
+		three := FromUint64(3)
+		e, _ := three.ModInverse(pMinusOne)
+		return a.ModExp(e, p), nil
+	}
+	third, _ := pMinusOne.DivModUint64(3)
+	if power := a.ModExp(third, p); !power.IsOne() {
+		return BigNumber{}, fmt.Errorf("cube root of %s modulo %s: %w", a.GetDecimal(), p.GetDecimal(), ErrNoSolution)
+	}
+	return adlemanMandersMiller(a, p), nil
+}
+
+// adlemanMandersMiller returns a cube root of the cubic residue a modulo the prime p ≡ 1 (mod 3).
+// With p - 1 = 3^s * t and 3*alpha ≡ 1 (mod t), (a^alpha)^3 = a * b where b = a^(3*alpha-1) lies in
+// the Sylow 3-subgroup; the cube root h of 1/b is found one base 3 digit at a time, like in
+// Tonelli-Shanks, and the root is a^alpha * h.
+func adlemanMandersMiller(a, p BigNumber) BigNumber {
+	pMinusOne, _ := p.SubUint64(1)
+	s, t := 0, pMinusOne
+	for {
+		quotient, r := t.DivModUint64(3)
+		if r != 0 {
+			break
+		}
+		s, t = s+1, quotient
+	}
+	alpha := FromUint64(1)
+	if !t.IsOne() {
+		three := FromUint64(3)
+		alpha, _ = three.ModInverse(t)
+	}
+	rho := nonResidue(p, 3)
+	// c generates the Sylow 3-subgroup and unity is a primitive cube root of one.
+	c := rho.ModExp(t, p)
+	unity := c
+	for i := 0; i < s-1; i++ {
+		unity = unity.POWMOD(p, 3)
+	}
+	e := alpha.MulUint64(3)
+	e, _ = e.SubUint64(1)
+	b := a.ModExp(e, p)
+	h := FromUint64(1)
+	for i := 1; i < s; i++ {
+		d := b
+		for j := 0; j < s-1-i; j++ {
+			d = d.POWMOD(p, 3)
+		}
+		// Multiplying b by c^(3j) multiplies d by unity^j.
+		j := uint64(0)
+		if d.Equal(unity) {
+			j = 2
+		} else if !d.IsOne() {
+			j = 1
+		}
+		cubed := c.POWMOD(p, 3)
+		b = mulMod(b, cubed.POWMOD(p, j), p)
+		h = mulMod(h, c.POWMOD(p, j), p)
+		c = cubed
+	}
+	root := a.ModExp(alpha, p)
+	return mulMod(root, h, p)
+}
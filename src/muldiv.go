@@ -0,0 +1,229 @@
+package bignumbers
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// MUL performs multiplication of two BigNumbers.
+func (bn *BigNumber) MUL(other BigNumber) (result BigNumber) {
+	result.setBlocks(mulBlocks(bn.blocks, other.blocks))
+	return
+}
+
+// DIV performs integer division of two BigNumbers. It panics with ErrDivisionByZero if other is zero.
+func (bn *BigNumber) DIV(other BigNumber) BigNumber {
+	quotient, _ := bn.DivMod(other)
+	return quotient
+}
+
+// DivMod returns the quotient and the remainder of the division of the BigNumber by other.
+// It panics with ErrDivisionByZero if other is zero.
+func (bn *BigNumber) DivMod(other BigNumber) (quotient, remainder BigNumber) {
+	divisor := norm(other.blocks)
+	switch {
+	case len(divisor) == 0:
+		panic(ErrDivisionByZero)
+	case bn.Cmp(other) < 0:
+		return BigNumber{}, bn.Clone()
+	case len(divisor) == 1:
+		blocks, r := divModBlocks(bn.blocks, divisor[0].GetDecimal())
+		quotient.setBlocks(blocks)
+		remainder = FromUint64(r)
+		return
+	}
+	q, r := divModLong(norm(bn.blocks), divisor)
+	quotient.setBlocks(q)
+	remainder.setBlocks(r)
+	return
+}
+
+// GCD returns the greatest common divisor of the BigNumber and other. GCD(0, 0) is 0.
+func (bn *BigNumber) GCD(other BigNumber) BigNumber {
+	a, b := bn.Clone(), other.Clone()
+	for !b.IsZero() {
+		a, b = b, a.MOD(b)
+	}
+	return a
+}
+
+// ModInverse returns the x in [0, m) with bn*x ≡ 1 (mod m).
+// It returns ErrNotInvertible if the BigNumber and m are not coprime or m is zero.
+func (bn *BigNumber) ModInverse(m BigNumber) (BigNumber, error) {
+	if m.IsZero() {
+		return BigNumber{}, fmt.Errorf("inverse modulo zero: %w", ErrNotInvertible)
+	}
+	// Extended Euclid keeping the Bezout coefficient of bn reduced modulo m, so it never goes negative.
+	oldR, r := bn.MOD(m), m.Clone()
+	one := FromUint64(1)
+	oldS, s := one.MOD(m), BigNumber{}
+	for !r.IsZero() {
+		q, rem := oldR.DivMod(r)
+		oldR, r = r, rem
+		oldS, s = s, subMod(oldS, mulMod(q, s, m), m)
+	}
+	if !oldR.IsOne() {
+		return BigNumber{}, fmt.Errorf("inverse of %s modulo %s: %w", bn.GetDecimal(), m.GetDecimal(), ErrNotInvertible)
+	}
+	return oldS, nil
+}
+
+// ModExp returns bn^e mod m. It panics with ErrDivisionByZero if m is zero.
+func (bn *BigNumber) ModExp(e, m BigNumber) BigNumber {
+	if m.IsZero() {
+		panic(ErrDivisionByZero)
+	}
+	base := bn.MOD(m)
+	one := FromUint64(1)
+	result := one.MOD(m)
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		result = mulMod(result, result, m)
+		if e.Bit(i) == 1 {
+			result = mulMod(result, base, m)
+		}
+	}
+	return result
+}
+
+// POWMOD returns bn^e mod m. It panics with ErrDivisionByZero if m is zero.
+func (bn *BigNumber) POWMOD(m BigNumber, e uint64) BigNumber {
+	return bn.ModExp(FromUint64(e), m)
+}
+
+// addMod returns (a + b) mod m for a, b in [0, m).
+func addMod(a, b, m BigNumber) BigNumber {
+	sum := a.ADD(b)
+	if sum.Cmp(m) >= 0 {
+		sum, _ = sum.SUB(m)
+	}
+	return sum
+}
+
+// subMod returns (a - b) mod m for a, b in [0, m).
+func subMod(a, b, m BigNumber) BigNumber {
+	if a.Cmp(b) >= 0 {
+		diff, _ := a.SUB(b)
+		return diff
+	}
+	diff, _ := m.SUB(b)
+	return diff.ADD(a)
+}
+
+// mulMod returns (a * b) mod m.
+func mulMod(a, b, m BigNumber) BigNumber {
+	product := a.MUL(b)
+	return product.MOD(m)
+}
+
+// mulBlocks returns the product of a and b without leading zero blocks.
+func mulBlocks(a, b []Uint) []Uint {
+	a, b = norm(a), norm(b)
+	if len(a) == 0 || len(b) == 0 {
+		return []Uint{}
+	}
+	result := make([]Uint, len(a)+len(b))
+	for i, x := range a {
+		if x.GetDecimal() == 0 {
+			continue
+		}
+		var carry uint64
+		for j, y := range b {
+			hi, lo := bits.Mul64(x.GetDecimal(), y.GetDecimal())
+			var c uint64
+			lo, c = bits.Add64(lo, result[i+j].GetDecimal(), 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			result[i+j] = Uint{lo}
+			carry = hi
+		}
+		result[i+len(b)] = Uint{carry}
+	}
+	return norm(result)
+}
+
+// divModLong divides u by v using Knuth's algorithm D (TAOCP vol. 2, 4.3.1).
+// v must have at least two blocks, u and v must be canonical and u >= v.
+// It returns the quotient and the remainder without leading zero blocks.
+func divModLong(u, v []Uint) ([]Uint, []Uint) {
+	n, m := len(v), len(u)-len(v)
+	// Normalize so that the top bit of the divisor is set; this keeps the quotient estimates off by at most two.
+	shift := uint(bits.LeadingZeros64(v[n-1].GetDecimal()))
+	vn := make([]Uint, n)
+	shiftLeftInto(vn, v, shift)
+	un := make([]Uint, len(u)+1)
+	un[len(u)] = shiftLeftInto(un, u, shift)
+	vTop, vNext := vn[n-1].GetDecimal(), vn[n-2].GetDecimal()
+
+	quotient := make([]Uint, m+1)
+	for j := m; j >= 0; j-- {
+		uTop := un[j+n].GetDecimal()
+		var qhat, rhat uint64
+		overflow := false
+		if uTop >= vTop {
+			qhat = ^uint64(0)
+			var c uint64
+			rhat, c = bits.Add64(un[j+n-1].GetDecimal(), vTop, 0)
+			overflow = c != 0
+		} else {
+			qhat, rhat = bits.Div64(uTop, un[j+n-1].GetDecimal(), vTop)
+		}
+		for !overflow {
+			hi, lo := bits.Mul64(qhat, vNext)
+			if hi < rhat || (hi == rhat && lo <= un[j+n-2].GetDecimal()) {
+				break
+			}
+			qhat--
+			var c uint64
+			rhat, c = bits.Add64(rhat, vTop, 0)
+			overflow = c != 0
+		}
+
+		// Multiply and subtract qhat*vn from un[j:j+n+1].
+		var carry, borrow uint64
+		for i := 0; i < n; i++ {
+			hi, lo := bits.Mul64(qhat, vn[i].GetDecimal())
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			carry = hi + c
+			var diff uint64
+			diff, borrow = bits.Sub64(un[i+j].GetDecimal(), lo, borrow)
+			un[i+j] = Uint{diff}
+		}
+		top, underflow := bits.Sub64(uTop, carry, borrow)
+		un[j+n] = Uint{top}
+
+		// qhat was one too large: add the divisor back.
+		if underflow != 0 {
+			qhat--
+			var c uint64
+			for i := 0; i < n; i++ {
+				var sum uint64
+				sum, c = bits.Add64(un[i+j].GetDecimal(), vn[i].GetDecimal(), c)
+				un[i+j] = Uint{sum}
+			}
+			un[j+n] = Uint{un[j+n].GetDecimal() + c}
+		}
+		quotient[j] = Uint{qhat}
+	}
+	// The remainder is un[:n] shifted back; it is computed in place.
+	remainder := un[:n]
+	spill := Uint{0}
+	for i := n - 1; i >= 0; i-- {
+		shifted, carry := remainder[i].ShiftR(shift)
+		remainder[i] = shifted.OR(spill)
+		spill = carry
+	}
+	return norm(quotient), norm(remainder)
+}
+
+// shiftLeftInto stores src shifted to the left by shift < 64 bits into dst[:len(src)] and returns the spill.
+func shiftLeftInto(dst, src []Uint, shift uint) Uint {
+	spill := Uint{0}
+	for i := range src {
+		shifted, carry := src[i].ShiftL(shift)
+		dst[i] = shifted.OR(spill)
+		spill = carry
+	}
+	return spill
+}
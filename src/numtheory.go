@@ -0,0 +1,247 @@
+package bignumbers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// Jacobi returns the Jacobi symbol (a/n), which is -1, 0 or 1. n must be odd; otherwise the
+// returned error wraps ErrDomain.
+func Jacobi(a, n BigNumber) (int, error) {
+	if n.Bit(0) == 0 {
+		return 0, fmt.Errorf("jacobi symbol modulo %s: %w", n.GetDecimal(), ErrDomain)
+	}
+	return jacobi(a, n), nil
+}
+
+// jacobi returns the Jacobi symbol (a/n) for an odd n using quadratic reciprocity.
+func jacobi(a, n BigNumber) int {
+	a = a.MOD(n)
+	result := 1
+	for !a.IsZero() {
+		// (2/n) is -1 exactly when n ≡ 3 or 5 (mod 8).
+		zeros := a.TrailingZeros()
+		a = a.ShiftR(zeros)
+		if r := n.low() % 8; zeros%2 == 1 && (r == 3 || r == 5) {
+			result = -result
+		}
+		if a.low()%4 == 3 && n.low()%4 == 3 {
+			result = -result
+		}
+		a, n = n.MOD(a), a
+	}
+	if !n.IsOne() {
+		return 0
+	}
+	return result
+}
+
+// Kronecker returns the Kronecker symbol (a/n), which extends the Jacobi symbol to every n.
+func Kronecker(a, n BigNumber) int {
+	if n.IsZero() {
+		if a.IsOne() {
+			return 1
+		}
+		return 0
+	}
+	result := 1
+	if zeros := n.TrailingZeros(); zeros > 0 {
+		// (a/2) is 0 for even a, 1 for a ≡ ±1 (mod 8) and -1 for a ≡ ±3 (mod 8).
+		switch a.low() % 8 {
+		case 0, 2, 4, 6:
+			return 0
+		case 3, 5:
+			if zeros%2 == 1 {
+				result = -1
+			}
+		}
+		n = n.ShiftR(zeros)
+	}
+	return result * jacobi(a, n)
+}
+
+// The functions below factor their argument with Factor, which runs until it succeeds or ctx is
+// done; in that case their error wraps ctx.Err(). Zero has no factorization and gives ErrDomain.
+
+// EulerPhi returns Euler's totient of n, the number of integers in [1, n] coprime with n.
+// The error wraps ErrDomain or ctx.Err().
+func EulerPhi(ctx context.Context, n BigNumber) (BigNumber, error) {
+	factors, err := factorize(ctx, "totient", n)
+	if err != nil {
+		return BigNumber{}, err
+	}
+	result := FromUint64(1)
+	for _, f := range factors {
+		// phi(p^e) = p^(e-1) * (p - 1)
+		pMinusOne, _ := f.Prime.SubUint64(1)
+		result = result.MUL(pMinusOne)
+		power := f.Prime.pow(uint64(f.Exponent - 1))
+		result = result.MUL(power)
+	}
+	return result, nil
+}
+
+// CarmichaelLambda returns the Carmichael function of n, the exponent of the multiplicative
+// group modulo n, which every multiplicative order divides. The error wraps ErrDomain or ctx.Err().
+func CarmichaelLambda(ctx context.Context, n BigNumber) (BigNumber, error) {
+	factors, err := factorize(ctx, "carmichael function", n)
+	if err != nil {
+		return BigNumber{}, err
+	}
+	return carmichaelLambda(factors), nil
+}
+
+// carmichaelLambda returns the Carmichael function of the number with the given factorization.
+func carmichaelLambda(factors []PrimeFactor) BigNumber {
+	result := FromUint64(1)
+	for _, f := range factors {
+		var lambda BigNumber
+		if f.Prime.CmpUint64(2) == 0 {
+			// The group modulo 2^e is not cyclic for e >= 3 and has exponent 2^(e-2).
+			exponent := f.Exponent - 1
+			if f.Exponent >= 3 {
+				exponent = f.Exponent - 2
+			}
+			two := FromUint64(2)
+			lambda = two.pow(uint64(exponent))
+		} else {
+			pMinusOne, _ := f.Prime.SubUint64(1)
+			power := f.Prime.pow(uint64(f.Exponent - 1))
+			lambda = power.MUL(pMinusOne)
+		}
+		g := result.GCD(lambda)
+		result = result.DIV(g)
+		result = result.MUL(lambda)
+	}
+	return result
+}
+
+// Mobius returns the Möbius function of n: 0 if n has a square factor, otherwise 1 or -1 for an
+// even or odd number of prime factors. The error wraps ErrDomain or ctx.Err().
+func Mobius(ctx context.Context, n BigNumber) (int, error) {
+	factors, err := factorize(ctx, "mobius function", n)
+	if err != nil {
+		return 0, err
+	}
+	result := 1
+	for _, f := range factors {
+		if f.Exponent > 1 {
+			return 0, nil
+		}
+		result = -result
+	}
+	return result, nil
+}
+
+// Divisors returns the positive divisors of n in increasing order. Their number grows quickly
+// with the number of prime factors of n. The error wraps ErrDomain or ctx.Err().
+func Divisors(ctx context.Context, n BigNumber) ([]BigNumber, error) {
+	factors, err := factorize(ctx, "divisors", n)
+	if err != nil {
+		return nil, err
+	}
+	divisors := []BigNumber{FromUint64(1)}
+	for _, f := range factors {
+		count := len(divisors)
+		power := FromUint64(1)
+		for e := 0; e < f.Exponent; e++ {
+			power = power.MUL(f.Prime)
+			for _, d := range divisors[:count] {
+				divisors = append(divisors, d.MUL(power))
+			}
+		}
+	}
+	sort.Slice(divisors, func(i, j int) bool {
+		return divisors[i].LessThan(divisors[j])
+	})
+	return divisors, nil
+}
+
+// SigmaK returns the sum of the k-th powers of the positive divisors of n; SigmaK(n, 0) is the
+// number of divisors. It is computed from the factorization without listing the divisors.
+// The error wraps ErrDomain or ctx.Err().
+func SigmaK(ctx context.Context, n BigNumber, k uint64) (BigNumber, error) {
+	factors, err := factorize(ctx, "divisor function", n)
+	if err != nil {
+		return BigNumber{}, err
+	}
+	result := FromUint64(1)
+	for _, f := range factors {
+		if k == 0 {
+			result = result.MulUint64(uint64(f.Exponent + 1))
+			continue
+		}
+		// 1 + p^k + ... + p^(k*e) = (p^(k*(e+1)) - 1) / (p^k - 1)
+		pk := f.Prime.pow(k)
+		numerator := pk.pow(uint64(f.Exponent + 1))
+		numerator, _ = numerator.SubUint64(1)
+		denominator, _ := pk.SubUint64(1)
+		result = result.MUL(numerator.DIV(denominator))
+	}
+	return result, nil
+}
+
+// MultiplicativeOrder returns the smallest k > 0 with a^k ≡ 1 (mod n). It factors both n and
+// the Carmichael function of n, which k divides. The error wraps ErrDomain for zero n,
+// ErrNotInvertible if a and n are not coprime, or ctx.Err().
+func MultiplicativeOrder(ctx context.Context, a, n BigNumber) (BigNumber, error) {
+	if n.IsZero() {
+		return BigNumber{}, fmt.Errorf("multiplicative order modulo zero: %w", ErrDomain)
+	}
+	if g := a.GCD(n); !g.IsOne() {
+		return BigNumber{}, fmt.Errorf("multiplicative order of %s modulo %s: %w", a.GetDecimal(), n.GetDecimal(), ErrNotInvertible)
+	}
+	factors, err := factorize(ctx, "multiplicative order", n)
+	if err != nil {
+		return BigNumber{}, err
+	}
+	order := carmichaelLambda(factors)
+	lambdaFactors, err := factorize(ctx, "multiplicative order", order)
+	if err != nil {
+		return BigNumber{}, err
+	}
+	for _, f := range lambdaFactors {
+		for e := 0; e < f.Exponent; e++ {
+			reduced := order.DIV(f.Prime)
+			if power := a.ModExp(reduced, n); !power.IsOne() {
+				break
+			}
+			order = reduced
+		}
+	}
+	return order, nil
+}
+
+// factorize returns the prime factorization of n for the named function. The error wraps
+// ErrDomain for zero, or ctx.Err() if ctx is done before n is factored.
+func factorize(ctx context.Context, name string, n BigNumber) ([]PrimeFactor, error) {
+	if n.IsZero() {
+		return nil, fmt.Errorf("%s of zero: %w", name, ErrDomain)
+	}
+	factors, err := Factor(ctx, n)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return factors, nil
+}
+
+// pow returns bn^e.
+func (bn *BigNumber) pow(e uint64) BigNumber {
+	result, base := FromUint64(1), bn.Clone()
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result = result.MUL(base)
+		}
+		if e > 1 {
+			base = base.MUL(base)
+		}
+	}
+	return result
+}
+
+// low returns the lowest block of the BigNumber.
+func (bn *BigNumber) low() uint64 {
+	low := blockAt(bn.blocks, 0)
+	return low.GetDecimal()
+}
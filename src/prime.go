@@ -0,0 +1,122 @@
+package bignumbers
+
+import (
+	cryptorand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+	"sync"
+)
+
+// smallPrimeLimit bounds the table of small primes used for trial division.
+const smallPrimeLimit = 1 << 16
+
+var (
+	smallPrimesOnce sync.Once
+	smallPrimes     []uint64
+)
+
+// smallPrimeTable returns the primes below smallPrimeLimit in increasing order.
+func smallPrimeTable() []uint64 {
+	smallPrimesOnce.Do(func() {
+		smallPrimes = sievePrimes(smallPrimeLimit)
+	})
+	return smallPrimes
+}
+
+// sievePrimes returns the primes below limit using the sieve of Eratosthenes.
+func sievePrimes(limit uint64) []uint64 {
+	var primes []uint64
+	composite := make([]bool, limit)
+	for i := uint64(2); i < limit; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j < limit; j += i {
+			composite[j] = true
+		}
+	}
+	return primes
+}
+
+// millerRabinBases make the Miller-Rabin test deterministic for all n < 3.3 * 10^24.
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41}
+
+// ProbablyPrime reports whether the BigNumber is probably prime. It runs trial division by the
+// small primes and Miller-Rabin with the first 13 primes as bases, which is exact for values
+// below 3.3 * 10^24, followed by n more rounds with bases drawn from crypto/rand. Above that
+// bound the probability that a composite passes is at most 4^-n, even for adversarial input.
+func (bn *BigNumber) ProbablyPrime(n int) bool {
+	if bn.CmpUint64(2) < 0 {
+		return false
+	}
+	for _, p := range smallPrimeTable()[:100] {
+		if bn.CmpUint64(p) == 0 {
+			return true
+		}
+		if _, r := bn.DivModUint64(p); r == 0 {
+			return false
+		}
+	}
+	// bn - 1 = d * 2^s with d odd.
+	nMinusOne, _ := bn.SubUint64(1)
+	s := nMinusOne.TrailingZeros()
+	d := nMinusOne.ShiftR(s)
+	witness := func(a BigNumber) bool {
+		x := a.ModExp(d, *bn)
+		if x.IsOne() || x.Equal(nMinusOne) {
+			return false
+		}
+		for i := 1; i < s; i++ {
+			x = mulMod(x, x, *bn)
+			if x.Equal(nMinusOne) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, a := range millerRabinBases {
+		if witness(FromUint64(a)) {
+			return false
+		}
+	}
+	rng := rand.New(cryptoSource{})
+	nMinusThree, _ := bn.SubUint64(3)
+	for i := 0; i < n; i++ {
+		// a is uniform enough in [2, bn-2] for the error bound.
+		a := randomBelow(rng, nMinusThree)
+		if witness(a.AddUint64(2)) {
+			return false
+		}
+	}
+	return true
+}
+
+// cryptoSource is a rand.Source reading from crypto/rand, so that the bases of ProbablyPrime
+// cannot be predicted from its input.
+type cryptoSource struct{}
+
+func (cryptoSource) Int63() int64 {
+	return int64(cryptoSource{}.Uint64() >> 1)
+}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := cryptorand.Read(b[:]); err != nil {
+		panic("bignumbers: crypto/rand failed: " + err.Error())
+	}
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+func (cryptoSource) Seed(int64) {}
+
+// randomBelow returns a pseudorandom BigNumber in [0, n). n must not be zero.
+func randomBelow(rng *rand.Rand, n BigNumber) BigNumber {
+	blocks := make([]Uint, len(n.blocks)+1)
+	for i := range blocks {
+		blocks[i] = Uint{rng.Uint64()}
+	}
+	var r BigNumber
+	r.setBlocks(blocks)
+	return r.MOD(n)
+}
@@ -0,0 +1,721 @@
+package bignumbers
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+	"sort"
+)
+
+// The self-initialising quadratic sieve looks for many x with (A*x + B)^2 - k*n = A*g(x) where
+// g(x) splits over a factor base of small primes p for which k*n is a square modulo p. The
+// exponent vectors of enough such relations are linearly dependent over GF(2), and multiplying
+// the relations of a dependency gives X^2 ≡ Z^2 (mod n), so gcd(X - Z, n) is likely a proper
+// factor. A is a product of s factor base primes and every A yields 2^(s-1) values of B, which
+// makes switching polynomials cheap.
+
+// qsParams are the factor base size and the sieve half-width for numbers of up to bits bits.
+type qsParams struct {
+	bits       int
+	factorBase int
+	halfWidth  int
+}
+
+var qsParamTable = []qsParams{
+	{100, 150, 32768},
+	{120, 250, 32768},
+	{140, 400, 32768},
+	{160, 700, 32768},
+	{180, 1200, 32768},
+	{200, 2200, 32768},
+	{220, 4000, 32768},
+	{240, 6000, 49152},
+	{260, 8000, 65536},
+	{280, 10000, 65536},
+	{300, 13000, 98304},
+	{330, 16000, 131072},
+}
+
+// qsUnsievedBound is the bound below which primes are not sieved; their logarithms are small
+// and they take the most steps, so they are accounted for in the threshold instead.
+const qsUnsievedBound = 100
+
+// qsLargePrimeFactor bounds the large prime of a partial relation as a multiple of the largest
+// factor base prime.
+const qsLargePrimeFactor = 128
+
+// qsThresholdSlack is how many factor base primes' worth of bits a sieve value may fall short of
+// the size of g and still be trial divided.
+const qsThresholdSlack = 2.7
+
+// qsChooseAttempts bounds the attempts at choosing a new leading coefficient; after half of
+// them a coefficient that was used before is accepted again.
+const qsChooseAttempts = 2000
+
+// qsExtraRelations is the number of relations collected beyond the matrix width, so that the
+// linear algebra finds that many dependencies.
+const qsExtraRelations = 24
+
+// QuadraticSieve returns a proper factor of the composite n using the self-initialising
+// quadratic sieve. It is meant for numbers with balanced factors of about 30 to 100 digits;
+// for even numbers and perfect powers it returns the obvious factor. It returns an error if n is
+// not composite and ctx.Err() if ctx is done before a factor is found.
+func QuadraticSieve(ctx context.Context, n BigNumber) (BigNumber, error) {
+	if n.CmpUint64(4) < 0 || n.ProbablyPrime(primalityRounds) {
+		return BigNumber{}, fmt.Errorf("quadratic sieve: %s is not composite", n.GetDecimal())
+	}
+	return siqs(ctx, n, rand.New(rand.NewSource(1)))
+}
+
+// siqs returns a proper factor of the composite n.
+func siqs(ctx context.Context, n BigNumber, rng *rand.Rand) (BigNumber, error) {
+	if n.Bit(0) == 0 {
+		return FromUint64(2), nil
+	}
+	if r, _, ok := n.perfectPower(); ok {
+		return r, nil
+	}
+	s, d, err := newQuadraticSieve(ctx, n, rng)
+	if err != nil || !d.IsZero() {
+		return d, err
+	}
+	for {
+		if err := s.collect(); err != nil {
+			return BigNumber{}, err
+		}
+		if d, ok := s.combine(); ok {
+			return d, nil
+		}
+		// Every dependency gave a trivial congruence; a few more relations give new ones.
+		s.needed += qsExtraRelations
+	}
+}
+
+// qsRelation is a relation y^2 ≡ product of its factors (mod n).
+type qsRelation struct {
+	y BigNumber
+	// factors are matrix columns with multiplicity: column 0 is the sign and column i + 1 is
+	// the i-th factor base prime.
+	factors []int
+	// large are primes outside the factor base that occur squared in the product.
+	large []uint64
+}
+
+// quadraticSieve holds the state of one factorization.
+type quadraticSieve struct {
+	ctx context.Context
+	rng *rand.Rand
+	n   BigNumber
+	// kn is n times a small multiplier that makes small primes more likely to be in the factor base.
+	kn BigNumber
+	// primes is the factor base, roots[i] is a square root of kn modulo primes[i] and logs[i]
+	// the rounded binary logarithm of primes[i].
+	primes []uint64
+	roots  []uint64
+	logs   []uint8
+	// sieved[i] reports whether primes[i] is sieved with, as opposed to tested on every candidate.
+	sieved    []bool
+	halfWidth int
+	// Sieve positions start at initial and are candidates once they reach cutoff, which is at least 128.
+	initial, cutoff uint8
+	// largeBound is the bound for the single large prime of a partial relation.
+	largeBound uint64
+	relations  []qsRelation
+	partials   map[uint64]qsRelation
+	needed     int
+	usedA      map[string]bool
+}
+
+// newQuadraticSieve selects the multiplier and the factor base. If a factor base prime divides
+// n it is returned as the factor instead.
+func newQuadraticSieve(ctx context.Context, n BigNumber, rng *rand.Rand) (*quadraticSieve, BigNumber, error) {
+	params := qsParamTable[len(qsParamTable)-1]
+	for _, p := range qsParamTable {
+		if n.BitLen() <= p.bits {
+			params = p
+			break
+		}
+	}
+	s := &quadraticSieve{
+		ctx:       ctx,
+		rng:       rng,
+		n:         n,
+		halfWidth: params.halfWidth,
+		partials:  make(map[uint64]qsRelation),
+		usedA:     make(map[string]bool),
+	}
+	k := knuthSchroeppel(n)
+	s.kn = n.MulUint64(k)
+
+	for bound := uint64(params.factorBase) * 16; len(s.primes) < params.factorBase; bound *= 2 {
+		s.primes, s.roots, s.logs, s.sieved = nil, nil, nil, nil
+		for _, p := range primesUpTo(bound) {
+			if len(s.primes) == params.factorBase {
+				break
+			}
+			_, r := s.kn.DivModUint64(p)
+			root := uint64(0)
+			switch {
+			case p == 2:
+				root = r
+			case r == 0:
+				if _, rn := n.DivModUint64(p); rn == 0 {
+					return nil, FromUint64(p), nil
+				}
+			case powMod64(r, (p-1)/2, p) != 1:
+				continue
+			default:
+				root = sqrtModPrime64(r, p)
+			}
+			s.primes = append(s.primes, p)
+			s.roots = append(s.roots, root)
+			s.logs = append(s.logs, uint8(math.Round(math.Log2(float64(p)))))
+			s.sieved = append(s.sieved, p >= qsUnsievedBound && r != 0)
+		}
+	}
+	largest := s.primes[len(s.primes)-1]
+	s.largeBound = largest * qsLargePrimeFactor
+	s.needed = len(s.primes) + 1 + qsExtraRelations
+
+	// Values of g are at most about halfWidth * sqrt(kn / 2). Candidates must reach that size up
+	// to about qsThresholdSlack factor base primes, covering a large prime, prime powers and the
+	// primes that are not sieved with.
+	logMax := math.Log2(float64(s.halfWidth)) + float64(s.kn.BitLen()-1)/2
+	threshold := int(math.Max(0, logMax-qsThresholdSlack*math.Log2(float64(largest))))
+	s.initial = uint8(max(0, 128-threshold))
+	s.cutoff = s.initial + uint8(threshold)
+	return s, BigNumber{}, nil
+}
+
+// knuthSchroeppel returns the multiplier k < 100 that maximises the expected contribution of the
+// small primes to the sieve for k*n.
+func knuthSchroeppel(n BigNumber) uint64 {
+	multipliers := []uint64{1, 3, 5, 7, 11, 13, 15, 17, 19, 21, 23, 29, 31, 33, 35, 37, 39, 41, 43, 47, 51, 53, 55, 57, 59, 61, 65, 67, 69, 71, 73, 77, 79, 83, 85, 87, 89, 91, 93, 95, 97}
+	primes := smallPrimeTable()[1:300]
+	residues := make([]uint64, len(primes))
+	for i, p := range primes {
+		_, residues[i] = n.DivModUint64(p)
+	}
+	_, n8 := n.DivModUint64(8)
+	best, bestScore := uint64(1), math.Inf(-1)
+	for _, k := range multipliers {
+		score := -0.5 * math.Log(float64(k))
+		switch k * n8 % 8 {
+		case 1:
+			score += 2 * math.Ln2
+		case 5:
+			score += math.Ln2
+		default:
+			score += 0.5 * math.Ln2
+		}
+		for i, p := range primes {
+			logP := math.Log(float64(p))
+			kn := k % p * residues[i] % p
+			switch {
+			case k%p == 0:
+				score += logP / float64(p)
+			case kn != 0 && powMod64(kn, (p-1)/2, p) == 1:
+				score += 2 * logP / float64(p-1)
+			}
+		}
+		if score > bestScore {
+			best, bestScore = k, score
+		}
+	}
+	return best
+}
+
+// collect sieves polynomials until enough relations have been found.
+func (s *quadraticSieve) collect() error {
+	for len(s.relations) < s.needed {
+		if err := s.ctx.Err(); err != nil {
+			return err
+		}
+		qs, err := s.chooseA()
+		if err != nil {
+			return err
+		}
+		s.sieveA(qs)
+	}
+	return nil
+}
+
+// chooseA returns the factor base indices of a new set of primes whose product is close to
+// sqrt(2*kn) / halfWidth, the value that minimises the size of g over the sieve interval.
+// It returns an error if the factor base has too few sieved primes, and ctx.Err() if ctx is done.
+func (s *quadraticSieve) chooseA() ([]int, error) {
+	target := s.kn.MulUint64(2)
+	target = target.sqrt()
+	target, _ = target.DivModUint64(uint64(s.halfWidth))
+	targetBits := float64(target.BitLen())
+
+	// Prefer primes of about 11 bits; with a small factor base use the upper part of it.
+	first := sort.Search(len(s.primes), func(i int) bool { return s.primes[i] >= qsUnsievedBound })
+	if first == len(s.primes) {
+		return nil, fmt.Errorf("quadratic sieve: the factor base has no sieved primes")
+	}
+	count := max(1, int(math.Round(targetBits/11)))
+	qBits := targetBits / float64(count)
+	for count > 1 && qBits > math.Log2(float64(s.primes[len(s.primes)*3/4])) {
+		count++
+		qBits = targetBits / float64(count)
+	}
+	for count > 1 && math.Exp2(qBits) < float64(s.primes[first]) {
+		count--
+		qBits = targetBits / float64(count)
+	}
+	lo := sort.Search(len(s.primes), func(i int) bool { return float64(s.primes[i]) >= math.Exp2(qBits)/1.5 })
+	hi := sort.Search(len(s.primes), func(i int) bool { return float64(s.primes[i]) > math.Exp2(qBits)*1.5 })
+	lo, hi = max(lo, first), min(hi, len(s.primes))
+	for hi-lo < 2*count+4 {
+		lo, hi = max(lo-2, first), min(hi+2, len(s.primes))
+		if lo == first && hi == len(s.primes) {
+			break
+		}
+	}
+	// All but the last prime are picked at random from the window, which must hold enough of them.
+	random := max(count-1, 1)
+	if s.countSieved(lo, hi) < random {
+		lo, hi = first, len(s.primes)
+	}
+	if available := s.countSieved(first, len(s.primes)); available < count || s.countSieved(lo, hi) < random {
+		return nil, fmt.Errorf("quadratic sieve: %d sieved factor base primes are too few for a %d-prime coefficient", available, count)
+	}
+
+	for attempt := 0; attempt < qsChooseAttempts; attempt++ {
+		if err := s.ctx.Err(); err != nil {
+			return nil, err
+		}
+		chosen := make([]int, 0, count)
+		product := FromUint64(1)
+		pick := func(i int) bool {
+			for _, c := range chosen {
+				if c == i {
+					return false
+				}
+			}
+			if !s.sieved[i] {
+				return false
+			}
+			chosen = append(chosen, i)
+			product = product.MulUint64(s.primes[i])
+			return true
+		}
+		for tries := 0; len(chosen) < random && tries < 64*(hi-lo); tries++ {
+			pick(lo + s.rng.Intn(hi-lo))
+		}
+		if len(chosen) < random {
+			continue
+		}
+		if len(chosen) < count {
+			// The last prime brings the product as close to the target as possible.
+			rest := target.DIV(product)
+			want, exact := rest.Uint64()
+			if !exact {
+				want = math.MaxUint64
+			}
+			i := sort.Search(len(s.primes), func(i int) bool { return s.primes[i] >= want })
+			i = min(max(i, first), len(s.primes)-1)
+			for d := 0; len(chosen) < count && d < len(s.primes); d++ {
+				if i+d < len(s.primes) && pick(i+d) {
+					break
+				}
+				if i-d-1 >= first && pick(i-d-1) {
+					break
+				}
+			}
+			if len(chosen) < count {
+				continue
+			}
+		}
+		sort.Ints(chosen)
+		key := fmt.Sprint(chosen)
+		if !s.usedA[key] || attempt >= qsChooseAttempts/2 {
+			s.usedA[key] = true
+			return chosen, nil
+		}
+	}
+	return nil, fmt.Errorf("quadratic sieve: no leading coefficient found after %d attempts", qsChooseAttempts)
+}
+
+// countSieved returns the number of sieved factor base primes with indices in [lo, hi).
+func (s *quadraticSieve) countSieved(lo, hi int) int {
+	count := 0
+	for _, sieved := range s.sieved[lo:hi] {
+		if sieved {
+			count++
+		}
+	}
+	return count
+}
+
+// sieveA sieves every polynomial with the leading coefficient formed by the given primes.
+func (s *quadraticSieve) sieveA(qs []int) {
+	a := FromUint64(1)
+	for _, i := range qs {
+		a = a.MulUint64(s.primes[i])
+	}
+	inA := make([]bool, len(s.primes))
+	for _, i := range qs {
+		inA[i] = true
+	}
+	// B = B[0] ± B[1] ± ... where B[j] ≡ ±sqrt(kn) (mod q[j]) and B[j] ≡ 0 modulo the other primes
+	// of A, so that B^2 ≡ kn (mod A).
+	parts := make([]BigNumber, len(qs))
+	for j, i := range qs {
+		q := s.primes[i]
+		cofactor, _ := a.DivModUint64(q)
+		_, c := cofactor.DivModUint64(q)
+		gamma := s.roots[i] * invMod64(c, q) % q
+		if gamma > q/2 {
+			gamma = q - gamma
+		}
+		parts[j] = cofactor.MulUint64(gamma)
+	}
+
+	// The first polynomial takes every part with a plus sign. g(x) ≡ 0 (mod p) for
+	// x ≡ (±root - B) / A, stored as offsets into the sieve, which starts at x = -halfWidth.
+	// Flipping the sign of B[j] moves both roots by ±2*B[j]/A, kept in steps[j].
+	var b BigNumber
+	bNegative := false
+	for j := range parts {
+		b = b.ADD(parts[j])
+	}
+	starts := make([][2]uint32, len(s.primes))
+	steps := make([][]uint32, len(parts))
+	for j := range steps {
+		steps[j] = make([]uint32, len(s.primes))
+	}
+	for i, p := range s.primes {
+		if !s.sieved[i] || inA[i] {
+			continue
+		}
+		_, r := a.DivModUint64(p)
+		aInverse := invMod64(r, p)
+		_, bResidue := b.DivModUint64(p)
+		offset := uint64(s.halfWidth) % p
+		root := s.roots[i]
+		x1 := (root + p - bResidue) % p * aInverse % p
+		x2 := (2*p - root - bResidue) % p * aInverse % p
+		starts[i] = [2]uint32{uint32((x1 + offset) % p), uint32((x2 + offset) % p)}
+		for j := range parts {
+			_, r := parts[j].DivModUint64(p)
+			steps[j][i] = uint32(2 * r % p * aInverse % p)
+		}
+	}
+
+	sieve := make([]uint8, 2*s.halfWidth)
+	// Candidates have the top bit set, which allows testing eight positions at a time.
+	initial := make([]uint8, len(sieve))
+	for i := range initial {
+		initial[i] = s.initial
+	}
+	negative := make([]bool, len(parts))
+	for polynomial := 0; ; polynomial++ {
+		if polynomial > 0 {
+			// Gray code order flips the sign of exactly one part per polynomial.
+			j := bits.TrailingZeros(uint(polynomial)) + 1
+			if j >= len(parts) || s.ctx.Err() != nil {
+				return
+			}
+			negative[j] = !negative[j]
+			twice := parts[j].MulUint64(2)
+			b, bNegative = signedAdd(b, bNegative, twice, negative[j])
+			for i, p := range s.primes {
+				if !s.sieved[i] || inA[i] {
+					continue
+				}
+				step := steps[j][i]
+				if negative[j] {
+					// B decreased, so the roots increase.
+					starts[i][0] = addMod32(starts[i][0], step, uint32(p))
+					starts[i][1] = addMod32(starts[i][1], step, uint32(p))
+				} else {
+					starts[i][0] = addMod32(starts[i][0], uint32(p)-step, uint32(p))
+					starts[i][1] = addMod32(starts[i][1], uint32(p)-step, uint32(p))
+				}
+			}
+		}
+
+		copy(sieve, initial)
+		for i, p := range s.primes {
+			if !s.sieved[i] || inA[i] {
+				continue
+			}
+			logP := s.logs[i]
+			for j := int(starts[i][0]); j < len(sieve); j += int(p) {
+				sieve[j] += logP
+			}
+			if starts[i][1] != starts[i][0] {
+				for j := int(starts[i][1]); j < len(sieve); j += int(p) {
+					sieve[j] += logP
+				}
+			}
+		}
+
+		for i := 0; i < len(sieve); i += 8 {
+			if binary.LittleEndian.Uint64(sieve[i:])&0x8080808080808080 == 0 {
+				continue
+			}
+			for k := i; k < i+8; k++ {
+				if sieve[k] >= s.cutoff {
+					s.check(k, a, qs, inA, b, bNegative, starts)
+				}
+			}
+		}
+	}
+}
+
+// check trial divides g(x) for the sieve position i and records the relation if it is smooth.
+func (s *quadraticSieve) check(i int, a BigNumber, qs []int, inA []bool, b BigNumber, bNegative bool, starts [][2]uint32) {
+	x := i - s.halfWidth
+	ax := a.MulUint64(uint64(max(x, -x)))
+	// y = A*x + B; only its magnitude matters.
+	var y BigNumber
+	if (x < 0) == bNegative {
+		y = ax.ADD(b)
+	} else {
+		y = absDiff(ax, b)
+	}
+	ySquared := y.MUL(y)
+	negative := ySquared.LessThan(s.kn)
+	g := absDiff(ySquared, s.kn)
+	g = g.DIV(a)
+	if g.IsZero() {
+		return
+	}
+
+	var factors []int
+	if negative {
+		factors = append(factors, 0)
+	}
+	for _, j := range qs {
+		factors = append(factors, j+1)
+	}
+	for j, p := range s.primes {
+		if s.sieved[j] && !inA[j] {
+			r := uint32(i) % uint32(p)
+			if r != starts[j][0] && r != starts[j][1] {
+				continue
+			}
+		}
+		for {
+			quotient, r := g.DivModUint64(p)
+			if r != 0 {
+				break
+			}
+			g = quotient
+			factors = append(factors, j+1)
+		}
+	}
+	y = y.MOD(s.n)
+	if g.IsOne() {
+		s.relations = append(s.relations, qsRelation{y: y, factors: factors})
+		return
+	}
+	large, exact := g.Uint64()
+	if !exact || large >= s.largeBound {
+		return
+	}
+	other, ok := s.partials[large]
+	if !ok {
+		s.partials[large] = qsRelation{y: y, factors: factors, large: []uint64{large}}
+		return
+	}
+	if other.y.Equal(y) {
+		return
+	}
+	// Two partial relations with the same large prime multiply to a full one with the prime squared.
+	s.relations = append(s.relations, qsRelation{
+		y:       mulMod(y, other.y, s.n),
+		factors: append(factors, other.factors...),
+		large:   []uint64{large},
+	})
+}
+
+// combine looks for dependencies between the relations and returns the first proper factor they yield.
+func (s *quadraticSieve) combine() (BigNumber, bool) {
+	vectors := make([][]int, len(s.relations))
+	for i := range s.relations {
+		vectors[i] = s.relations[i].factors
+	}
+	for _, dependency := range gf2Dependencies(vectors, len(s.primes)+1) {
+		x := FromUint64(1)
+		exponents := make([]int, len(s.primes)+1)
+		z := FromUint64(1)
+		for _, r := range dependency {
+			relation := &s.relations[r]
+			x = mulMod(x, relation.y, s.n)
+			for _, column := range relation.factors {
+				exponents[column]++
+			}
+			for _, l := range relation.large {
+				z = z.MulUint64(l)
+				z = z.MOD(s.n)
+			}
+		}
+		for column := 1; column < len(exponents); column++ {
+			for e := 0; e < exponents[column]/2; e++ {
+				z = z.MulUint64(s.primes[column-1])
+				z = z.MOD(s.n)
+			}
+		}
+		diff := absDiff(x, z)
+		d := diff.GCD(s.n)
+		if !d.IsOne() && !d.Equal(s.n) {
+			return d, true
+		}
+	}
+	return BigNumber{}, false
+}
+
+// gf2Dependencies returns sets of vectors that sum to zero over GF(2). Every vector lists the
+// columns of its non-zero entries, possibly repeated. It uses Gaussian elimination on the rows
+// augmented with the identity matrix, which records how every reduced row was combined.
+func gf2Dependencies(vectors [][]int, columns int) [][]int {
+	words := (columns + 63) / 64
+	width := words + (len(vectors)+63)/64
+	rows := make([][]uint64, len(vectors))
+	for i, vector := range vectors {
+		rows[i] = make([]uint64, width)
+		for _, c := range vector {
+			rows[i][c/64] ^= 1 << (c % 64)
+		}
+		rows[i][words+i/64] |= 1 << (i % 64)
+	}
+	pivot := make([]bool, len(rows))
+	for c := 0; c < columns; c++ {
+		p := -1
+		for i := range rows {
+			if !pivot[i] && rows[i][c/64]>>(c%64)&1 == 1 {
+				p = i
+				break
+			}
+		}
+		if p < 0 {
+			continue
+		}
+		pivot[p] = true
+		for i := range rows {
+			if i != p && rows[i][c/64]>>(c%64)&1 == 1 {
+				for w := range rows[i] {
+					rows[i][w] ^= rows[p][w]
+				}
+			}
+		}
+	}
+	var dependencies [][]int
+	for i := range rows {
+		if pivot[i] {
+			continue
+		}
+		var dependency []int
+		for w, word := range rows[i][words:] {
+			for word != 0 {
+				dependency = append(dependency, w*64+bits.TrailingZeros64(word))
+				word &= word - 1
+			}
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies
+}
+
+// signedAdd returns the magnitude and the sign of x + y, where both are given the same way.
+func signedAdd(x BigNumber, xNegative bool, y BigNumber, yNegative bool) (BigNumber, bool) {
+	if xNegative == yNegative {
+		return x.ADD(y), xNegative
+	}
+	if x.LessThan(y) {
+		diff, _ := y.SUB(x)
+		return diff, yNegative
+	}
+	diff, _ := x.SUB(y)
+	return diff, xNegative
+}
+
+// addMod32 returns (a + b) mod m for a, b < m.
+func addMod32(a, b, m uint32) uint32 {
+	sum := uint64(a) + uint64(b)
+	if sum >= uint64(m) {
+		sum -= uint64(m)
+	}
+	return uint32(sum)
+}
+
+// powMod64 returns a^e mod m.
+func powMod64(a, e, m uint64) uint64 {
+	result := 1 % m
+	a %= m
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result = mulMod64(result, a, m)
+		}
+		a = mulMod64(a, a, m)
+	}
+	return result
+}
+
+// mulMod64 returns a*b mod m for a, b < m.
+func mulMod64(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	_, r := bits.Div64(hi, lo, m)
+	return r
+}
+
+// invMod64 returns the inverse of a modulo m, or 0 if there is none.
+func invMod64(a, m uint64) uint64 {
+	oldR, r := int64(a%m), int64(m)
+	oldS, s := int64(1), int64(0)
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldS, s = s, oldS-q*s
+	}
+	if oldR != 1 {
+		return 0
+	}
+	if oldS < 0 {
+		oldS += int64(m)
+	}
+	return uint64(oldS)
+}
+
+// sqrtModPrime64 returns a square root of the quadratic residue a modulo the odd prime p with
+// the Tonelli-Shanks algorithm.
+func sqrtModPrime64(a, p uint64) uint64 {
+	a %= p
+	if a == 0 {
+		return 0
+	}
+	if p%4 == 3 {
+		return powMod64(a, (p+1)/4, p)
+	}
+	// p - 1 = q * 2^s with q odd.
+	q, s := p-1, 0
+	for q%2 == 0 {
+		q, s = q/2, s+1
+	}
+	z := uint64(2)
+	for powMod64(z, (p-1)/2, p) != p-1 {
+		z++
+	}
+	m, c, t, r := s, powMod64(z, q, p), powMod64(a, q, p), powMod64(a, (q+1)/2, p)
+	for t != 1 {
+		i, t2 := 0, t
+		for t2 != 1 {
+			t2 = mulMod64(t2, t2, p)
+			i++
+		}
+		b := c
+		for j := 0; j < m-i-1; j++ {
+			b = mulMod64(b, b, p)
+		}
+		m, c = i, mulMod64(b, b, p)
+		t, r = mulMod64(t, c, p), mulMod64(r, b, p)
+	}
+	return r
+}
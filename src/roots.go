@@ -0,0 +1,46 @@
+package bignumbers
+
+// sqrt returns the integer square root of the BigNumber, the largest r with r*r <= bn.
+func (bn *BigNumber) sqrt() BigNumber {
+	return bn.root(2)
+}
+
+// root returns the integer k-th root of the BigNumber, the largest r with r^k <= bn, using Newton's method.
+func (bn *BigNumber) root(k int) BigNumber {
+	if bn.IsZero() {
+		return BigNumber{}
+	}
+	// Start above the root so that the iteration decreases monotonically.
+	var x BigNumber
+	x.SetBit((bn.BitLen()+k-1)/k, 1)
+	for {
+		// y = ((k-1)*x + bn / x^(k-1)) / k
+		power := FromUint64(1)
+		for i := 1; i < k; i++ {
+			power = power.MUL(x)
+		}
+		quotient := bn.DIV(power)
+		y := x.MulUint64(uint64(k - 1))
+		y = y.ADD(quotient)
+		y, _ = y.DivModUint64(uint64(k))
+		if !y.LessThan(x) {
+			return x
+		}
+		x = y
+	}
+}
+
+// perfectPower returns r and k > 1 with r^k = bn if the BigNumber is a perfect power.
+func (bn *BigNumber) perfectPower() (BigNumber, int, bool) {
+	for _, k := range primesUpTo(uint64(bn.BitLen())) {
+		r := bn.root(int(k))
+		power := FromUint64(1)
+		for i := uint64(0); i < k; i++ {
+			power = power.MUL(r)
+		}
+		if power.Equal(*bn) {
+			return r, int(k), true
+		}
+	}
+	return BigNumber{}, 0, false
+}
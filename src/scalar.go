@@ -0,0 +1,102 @@
+package bignumbers
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// AddUint64 returns the sum of the BigNumber and x.
+func (bn *BigNumber) AddUint64(x uint64) (result BigNumber) {
+	blocks := make([]Uint, len(bn.blocks), len(bn.blocks)+1)
+	copy(blocks, bn.blocks)
+	result.setBlocks(mulAddBlocks(blocks, 1, x))
+	return
+}
+
+// SubUint64 returns the difference of the BigNumber and x. It returns ErrNegativeResult if x is greater than the BigNumber.
+func (bn *BigNumber) SubUint64(x uint64) (result BigNumber, err error) {
+	if bn.CmpUint64(x) < 0 {
+		return BigNumber{}, fmt.Errorf("sub %w", ErrNegativeResult)
+	}
+	blocks := make([]Uint, len(bn.blocks))
+	borrow := x
+	for i, block := range bn.blocks {
+		var diff uint64
+		diff, borrow = bits.Sub64(block.GetDecimal(), borrow, 0)
+		blocks[i] = Uint{diff}
+	}
+	result.setBlocks(blocks)
+	return
+}
+
+// MulUint64 returns the product of the BigNumber and x.
+func (bn *BigNumber) MulUint64(x uint64) (result BigNumber) {
+	if x == 0 {
+		return
+	}
+	blocks := make([]Uint, len(bn.blocks), len(bn.blocks)+1)
+	copy(blocks, bn.blocks)
+	result.setBlocks(mulAddBlocks(blocks, x, 0))
+	return
+}
+
+// DivModUint64 returns the quotient and the remainder of the division of the BigNumber by x.
+// It panics with ErrDivisionByZero if x is zero.
+func (bn *BigNumber) DivModUint64(x uint64) (quotient BigNumber, remainder uint64) {
+	if x == 0 {
+		panic(ErrDivisionByZero)
+	}
+	blocks, remainder := divModBlocks(bn.blocks, x)
+	quotient.setBlocks(blocks)
+	return
+}
+
+// CmpUint64 compares the BigNumber with x and returns -1, 0 or +1.
+func (bn *BigNumber) CmpUint64(x uint64) int {
+	blocks := norm(bn.blocks)
+	switch {
+	case len(blocks) > 1:
+		return 1
+	case len(blocks) == 0:
+		if x == 0 {
+			return 0
+		}
+		return -1
+	case blocks[0].GetDecimal() < x:
+		return -1
+	case blocks[0].GetDecimal() > x:
+		return 1
+	}
+	return 0
+}
+
+// mulAddBlocks computes blocks*m + a in place and returns the (possibly grown) slice.
+func mulAddBlocks(blocks []Uint, m, a uint64) []Uint {
+	carry := a
+	for i, block := range blocks {
+		hi, lo := bits.Mul64(block.GetDecimal(), m)
+		var c uint64
+		lo, c = bits.Add64(lo, carry, 0)
+		blocks[i] = Uint{lo}
+		carry = hi + c
+	}
+	if carry > 0 {
+		blocks = append(blocks, Uint{carry})
+	}
+	return blocks
+}
+
+// divModBlocks divides blocks by d and returns the quotient without leading zero blocks and the remainder.
+func divModBlocks(blocks []Uint, d uint64) ([]Uint, uint64) {
+	quotient := make([]Uint, len(blocks))
+	remainder := uint64(0)
+	for i := len(blocks) - 1; i >= 0; i-- {
+		var q uint64
+		q, remainder = bits.Div64(remainder, blocks[i].GetDecimal(), d)
+		quotient[i] = Uint{q}
+	}
+	for len(quotient) > 0 && quotient[len(quotient)-1].GetDecimal() == 0 {
+		quotient = quotient[:len(quotient)-1]
+	}
+	return quotient, remainder
+}
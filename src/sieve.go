@@ -0,0 +1,146 @@
+package bignumbers
+
+import (
+	"fmt"
+)
+
+// sieveSegmentSize is the number of values sieved at a time.
+const sieveSegmentSize = 1 << 15
+
+// sieveExactLimit is the square of smallPrimeLimit. Below it, a value without a factor in the
+// small prime table is prime; from it on, survivors of the sieve are checked with Miller-Rabin.
+const sieveExactLimit = smallPrimeLimit * smallPrimeLimit
+
+// primeSearchWindow is the width of the intervals searched by NextPrime and PrevPrime. It is
+// several times the average prime gap at a few thousand bits, so that a window rarely comes up
+// empty, while sparing the Miller-Rabin tests of a whole segment past the prime.
+const primeSearchWindow = 1 << 12
+
+// PrimeSieve enumerates the primes of the interval [lo, hi] in increasing order with a segmented
+// sieve of Eratosthenes:
+//
+//	sieve := bignumbers.NewPrimeSieve(lo, hi)
+//	for sieve.Next() {
+//		p := sieve.Prime()
+//		...
+//	}
+//
+// Every segment is sieved with the small prime table, which is exact below 2^32; larger values
+// that survive are tested with ProbablyPrime. A PrimeSieve must not be used concurrently.
+type PrimeSieve struct {
+	hi BigNumber
+	// start is the first value of the current segment and composite marks its values.
+	start     BigNumber
+	composite []bool
+	index     int
+	prime     BigNumber
+	done      bool
+}
+
+// NewPrimeSieve returns a sieve over the primes in [lo, hi].
+func NewPrimeSieve(lo, hi BigNumber) *PrimeSieve {
+	s := &PrimeSieve{hi: hi.Clone(), start: lo.Clone()}
+	if s.start.CmpUint64(2) < 0 {
+		s.start = FromUint64(2)
+	}
+	s.done = s.hi.LessThan(s.start)
+	if !s.done {
+		s.sieveSegment()
+	}
+	return s
+}
+
+// Next advances to the next prime and reports whether there is one.
+func (s *PrimeSieve) Next() bool {
+	for !s.done {
+		for ; s.index < len(s.composite); s.index++ {
+			if s.composite[s.index] {
+				continue
+			}
+			candidate := s.start.AddUint64(uint64(s.index))
+			if candidate.CmpUint64(sieveExactLimit) >= 0 && !candidate.ProbablyPrime(primalityRounds) {
+				continue
+			}
+			s.index++
+			s.prime = candidate
+			return true
+		}
+		s.start = s.start.AddUint64(uint64(len(s.composite)))
+		if s.hi.LessThan(s.start) {
+			s.done = true
+			break
+		}
+		s.sieveSegment()
+	}
+	s.prime = BigNumber{}
+	return false
+}
+
+// Prime returns the prime found by the last call to Next.
+func (s *PrimeSieve) Prime() BigNumber {
+	return s.prime
+}
+
+// sieveSegment marks the composites among the values from start up to hi, at most
+// sieveSegmentSize of them.
+func (s *PrimeSieve) sieveSegment() {
+	size := uint64(sieveSegmentSize)
+	if span, _ := s.hi.SUB(s.start); span.CmpUint64(size-1) < 0 {
+		size, _ = span.Uint64()
+		size++
+	}
+	s.composite = make([]bool, size)
+	s.index = 0
+	end := s.start.AddUint64(size - 1)
+	for _, p := range smallPrimeTable() {
+		if end.CmpUint64(p*p) < 0 {
+			break
+		}
+		_, r := s.start.DivModUint64(p)
+		first := (p - r) % p
+		// Keep p itself, which is in the segment only if start <= p.
+		if s.start.CmpUint64(p-first) == 0 {
+			first += p
+		}
+		for i := first; i < size; i += p {
+			s.composite[i] = true
+		}
+	}
+}
+
+// NextPrime returns the smallest prime greater than n.
+func NextPrime(n BigNumber) BigNumber {
+	lo := n.AddUint64(1)
+	for {
+		hi := lo.AddUint64(primeSearchWindow - 1)
+		sieve := NewPrimeSieve(lo, hi)
+		if sieve.Next() {
+			return sieve.Prime()
+		}
+		lo = hi.AddUint64(1)
+	}
+}
+
+// PrevPrime returns the largest prime smaller than n. The returned error wraps ErrDomain if n is
+// 2 or less.
+func PrevPrime(n BigNumber) (BigNumber, error) {
+	if n.CmpUint64(2) <= 0 {
+		return BigNumber{}, fmt.Errorf("no prime below %s: %w", n.GetDecimal(), ErrDomain)
+	}
+	hi, _ := n.SubUint64(1)
+	for {
+		lo, err := hi.SubUint64(primeSearchWindow - 1)
+		if err != nil {
+			lo = BigNumber{}
+		}
+		var last BigNumber
+		found := false
+		for sieve := NewPrimeSieve(lo, hi); sieve.Next(); {
+			last, found = sieve.Prime(), true
+		}
+		if found {
+			return last, nil
+		}
+		hi, _ = lo.SubUint64(1)
+	}
+}
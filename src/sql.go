@@ -0,0 +1,123 @@
+package bignumbers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// SQLMode selects how a BigNumber is written to and read from a database column.
+type SQLMode int
+
+const (
+	// SQLAuto writes decimal text and, on scan, accepts decimal or prefixed text. It never reads raw
+	// bytes, which could be mistaken for text, so use SQLBytes for BYTEA columns.
+	SQLAuto SQLMode = iota
+	// SQLDecimal writes and reads decimal text, suitable for NUMERIC columns.
+	SQLDecimal
+	// SQLHex writes 0x-prefixed hex text and reads hex text with or without the prefix.
+	SQLHex
+	// SQLBytes writes and reads the raw big-endian magnitude, suitable for BYTEA columns.
+	SQLBytes
+)
+
+// SQLValue binds a BigNumber to an SQLMode. It implements both sql.Scanner and driver.Valuer.
+// BigNumber itself implements only driver.Valuer, since its Scan method implements fmt.Scanner,
+// so scan columns through bn.SQL(mode).
+type SQLValue struct {
+	Number *BigNumber
+	Mode   SQLMode
+}
+
+var (
+	_ sql.Scanner   = (*SQLValue)(nil)
+	_ driver.Valuer = SQLValue{}
+	_ driver.Valuer = BigNumber{}
+)
+
+// SQL returns an SQLValue that reads and writes the BigNumber using the provided mode.
+func (bn *BigNumber) SQL(mode SQLMode) SQLValue {
+	return SQLValue{Number: bn, Mode: mode}
+}
+
+// Value implements driver.Valuer. The value is written as decimal text.
+func (bn BigNumber) Value() (driver.Value, error) {
+	return bn.SQL(SQLAuto).Value()
+}
+
+// Value implements driver.Valuer.
+func (v SQLValue) Value() (driver.Value, error) {
+	if v.Number == nil {
+		return nil, nil
+	}
+	switch v.Mode {
+	case SQLAuto, SQLDecimal:
+		decimal := v.Number.GetDecimal()
+		if decimal == "" {
+			decimal = "0"
+		}
+		return decimal, nil
+	case SQLHex:
+		text, err := v.Number.MarshalText()
+		return string(text), err
+	case SQLBytes:
+		return v.Number.GetBytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown SQL mode %d", v.Mode)
+	}
+}
+
+// Scan implements sql.Scanner.
+func (v SQLValue) Scan(src any) error {
+	if v.Number == nil {
+		return fmt.Errorf("cannot scan into a nil BigNumber")
+	}
+	switch src := src.(type) {
+	case nil:
+		return fmt.Errorf("cannot scan NULL into BigNumber")
+	case int64:
+		result, err := FromInt64(src)
+		if err != nil {
+			return fmt.Errorf("cannot scan %d into BigNumber: %w", src, err)
+		}
+		*v.Number = result
+		return nil
+	case string:
+		return v.scanText(src)
+	case []byte:
+		switch v.Mode {
+		case SQLBytes:
+			v.Number.SetBytes(src)
+			return nil
+		case SQLAuto:
+			if !isText(src) {
+				return fmt.Errorf("cannot scan %d raw bytes into BigNumber in auto mode, use SQLBytes", len(src))
+			}
+			return v.scanText(string(src))
+		default:
+			return v.scanText(string(src))
+		}
+	default:
+		return fmt.Errorf("cannot scan %T into BigNumber", src)
+	}
+}
+
+// scanText parses textual column data according to the mode.
+func (v SQLValue) scanText(text string) error {
+	text = strings.TrimSpace(text)
+	if v.Mode == SQLHex && !strings.HasPrefix(text, "0x") && !strings.HasPrefix(text, "0X") {
+		text = "0x" + text
+	}
+	return v.Number.UnmarshalText([]byte(text))
+}
+
+// isText reports whether the column data consists of printable ASCII characters, as text columns do.
+func isText(data []byte) bool {
+	for _, c := range data {
+		if (c < ' ' && c != '\t' && c != '\n' && c != '\r') || c > '~' {
+			return false
+		}
+	}
+	return true
+}
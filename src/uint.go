@@ -2,7 +2,8 @@ package bignumbers
 
 import (
 	"fmt"
-	"math"
+	"math/bits"
+	"strings"
 )
 
 type Uint struct {
@@ -25,21 +26,17 @@ func (u *Uint) SetHex(hex string) error {
 	if err != nil {
 		return err
 	}
+	u.setHexDigits(validatedHex)
+	return nil
+}
 
+// setHexDigits sets the value from at most 16 validated lower-case hex digits.
+func (u *Uint) setHexDigits(hex string) {
 	value := *new(uint64)
-	for i, r := range validatedHex {
-		pow := math.Pow(16, float64(len(validatedHex)-i-1))
-		if r >= '0' && r <= '9' {
-			left := uint64(r - '0')
-			value += left * uint64(pow)
-		} else {
-			left := uint64(uint64(r-'a') + 10)
-			value += left * uint64(pow)
-		}
+	for _, r := range hex {
+		value = value<<4 | uint64(strings.IndexRune(hexDigits, r))
 	}
 	u.SetDecimal(value)
-
-	return nil
 }
 
 func (u *Uint) GetDecimal() uint64 {
@@ -60,19 +57,21 @@ func (u *Uint) GetBinary() (bin string) {
 }
 
 func (u *Uint) SetBinary(bin string) error {
-	if err := ValidateBinary(bin); err != nil {
+	bin, err := parseDigits(bin, 2, "0b", 64)
+	if err != nil {
 		return err
 	}
+	u.setBinaryDigits(bin)
+	return nil
+}
 
+// setBinaryDigits sets the value from at most 64 validated binary digits.
+func (u *Uint) setBinaryDigits(bin string) {
 	value := *new(uint64)
-	for i, digit := range bin {
-		left := uint64(digit - '0')
-		right := uint64(math.Pow(2, float64(len(bin)-i-1)))
-		value += left * right
+	for _, digit := range bin {
+		value = value<<1 | uint64(digit-'0')
 	}
 	u.SetDecimal(value)
-
-	return nil
 }
 
 func (u *Uint) Invert() Uint {
@@ -98,3 +97,53 @@ func (u *Uint) ADD(other Uint) Uint {
 func (u *Uint) SUB(other Uint) Uint {
 	return Uint{u.GetDecimal() - other.GetDecimal()}
 }
+
+// AddCarry returns u + other + carry and the carry out. The carry must be 0 or 1.
+func (u *Uint) AddCarry(other Uint, carry uint64) (Uint, uint64) {
+	sum, carryOut := bits.Add64(u.GetDecimal(), other.GetDecimal(), carry)
+	return Uint{sum}, carryOut
+}
+
+// SubBorrow returns u - other - borrow and the borrow out. The borrow must be 0 or 1.
+func (u *Uint) SubBorrow(other Uint, borrow uint64) (Uint, uint64) {
+	diff, borrowOut := bits.Sub64(u.GetDecimal(), other.GetDecimal(), borrow)
+	return Uint{diff}, borrowOut
+}
+
+// Mul128 returns the 128-bit product of u and other as its high and low halves.
+func (u *Uint) Mul128(other Uint) (hi, lo Uint) {
+	h, l := bits.Mul64(u.GetDecimal(), other.GetDecimal())
+	return Uint{h}, Uint{l}
+}
+
+// Div128 divides the 128-bit value whose high half is u and low half is lo by d.
+// It panics if d is zero or not greater than u, since the quotient would not fit into a Uint.
+func (u *Uint) Div128(lo, d Uint) (quo, rem Uint) {
+	q, r := bits.Div64(u.GetDecimal(), lo.GetDecimal(), d.GetDecimal())
+	return Uint{q}, Uint{r}
+}
+
+// LeadingZeros returns the number of leading zero bits in u. The result is 64 for zero.
+func (u *Uint) LeadingZeros() int {
+	return bits.LeadingZeros64(u.GetDecimal())
+}
+
+// ShiftL returns u shifted to the left by n bits, 0 <= n <= 64, and the spill:
+// the bits shifted out, aligned to be ORed into the next more significant block.
+func (u *Uint) ShiftL(n uint) (result, spill Uint) {
+	value := u.GetDecimal()
+	if n == 0 {
+		return Uint{value}, Uint{0}
+	}
+	return Uint{value << n}, Uint{value >> (64 - n)}
+}
+
+// ShiftR returns u shifted to the right by n bits, 0 <= n <= 64, and the spill:
+// the bits shifted out, aligned to be ORed into the next less significant block.
+func (u *Uint) ShiftR(n uint) (result, spill Uint) {
+	value := u.GetDecimal()
+	if n == 0 {
+		return Uint{value}, Uint{0}
+	}
+	return Uint{value >> n}, Uint{value << (64 - n)}
+}
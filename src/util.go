@@ -1,31 +1,20 @@
 package bignumbers
 
 import (
-	"math"
 	"strings"
 )
 
-func reverseString(s string) string {
-	runeArray := []rune(s)
-	for i := 0; i < len(runeArray)/2; i++ {
-		runeArray[i], runeArray[len(runeArray)-i-1] = runeArray[len(runeArray)-i-1], runeArray[i]
-	}
-	return string(runeArray)
-}
-
+// breakStringIntoBlocks splits input into blocks of blockSize characters, starting from the end of the string.
+// The first block holds the least significant characters; the last one may be shorter.
 func breakStringIntoBlocks(input string, blockSize int) []string {
-	numberOfBlocks := uint(math.Ceil(float64(len(input)) / float64(blockSize)))
-	blocks := make([]string, numberOfBlocks)
-	reversedInput := reverseString(input)
-
-	for i := 0; i < int(numberOfBlocks); i++ {
-		if i*blockSize+blockSize > len(reversedInput) {
-			blocks[i] = reverseString(reversedInput[i*blockSize:])
-		} else {
-			blocks[i] = reverseString(reversedInput[i*blockSize : i*blockSize+blockSize])
+	blocks := make([]string, 0, (len(input)+blockSize-1)/blockSize)
+	for end := len(input); end > 0; end -= blockSize {
+		start := end - blockSize
+		if start < 0 {
+			start = 0
 		}
+		blocks = append(blocks, input[start:end])
 	}
-
 	return blocks
 }
 
@@ -1,30 +1,60 @@
 package bignumbers
 
 import (
-	"fmt"
 	"strings"
 )
 
-func ValidateHex(hex string) (string, error) {
-	hex = strings.ToLower(hex)
-	if len(hex) > 16 {
-		return "", fmt.Errorf("max hex length is 16")
+// parseDigits removes an optional prefix and the '_' digit separators from input and checks
+// that the remaining characters are digits in base. As in Go literals, a separator must sit
+// between two digits or between the prefix and a digit. maxDigits limits the number of digits,
+// zero means no limit. It returns the lower-case digits; errors are *ParseError.
+func parseDigits(input string, base int, prefix string, maxDigits int) (string, error) {
+	start := 0
+	if prefix != "" && len(input) >= len(prefix) && strings.EqualFold(input[:len(prefix)], prefix) {
+		start = len(prefix)
 	}
-	for _, r := range hex {
-		if !strings.ContainsRune(hexDigits, r) {
-			return "", fmt.Errorf("'%s' is not a hex digit", string(r))
+	digits := hexDigits[:base]
+	var sb strings.Builder
+	sb.Grow(len(input) - start)
+	for i := start; i < len(input); i++ {
+		c := input[i]
+		if c == '_' {
+			if i == 0 || i == len(input)-1 || input[i-1] == '_' {
+				return "", &ParseError{Input: input, Offset: i, Base: base, Err: ErrInvalidSeparator}
+			}
+			continue
+		}
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if strings.IndexByte(digits, c) < 0 {
+			return "", &ParseError{Input: input, Offset: i, Base: base, Err: ErrInvalidDigit}
 		}
+		if maxDigits > 0 && sb.Len() == maxDigits {
+			return "", &ParseError{Input: input, Offset: i, Base: base, Err: ErrTooManyDigits}
+		}
+		sb.WriteByte(c)
 	}
-	return hex, nil
+	return sb.String(), nil
+}
+
+// ValidateHex validates a hex string of at most 16 digits. An optional 0x prefix and '_' digit separators are allowed.
+// It returns the lower-case digits without the prefix and separators.
+func ValidateHex(hex string) (string, error) {
+	return parseDigits(hex, 16, "0x", 16)
 }
 
+// ValidateBinary validates a binary string of at most 64 digits. An optional 0b prefix and '_' digit separators are allowed.
 func ValidateBinary(bin string) error {
-	if len(bin) > 64 {
-		return fmt.Errorf("max binary length is 64")
-	}
-	for _, digit := range bin {
-		if digit != '0' && digit != '1' {
-			return fmt.Errorf("binary string must contain only ones and zeros")
+	_, err := parseDigits(bin, 2, "0b", 64)
+	return err
+}
+
+// ValidateDecimal validates a decimal string without separators.
+func ValidateDecimal(decimal string) error {
+	for i := 0; i < len(decimal); i++ {
+		if decimal[i] < '0' || decimal[i] > '9' {
+			return &ParseError{Input: decimal, Offset: i, Base: 10, Err: ErrInvalidDigit}
 		}
 	}
 	return nil
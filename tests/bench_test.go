@@ -0,0 +1,157 @@
+package bignumbers_test
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// benchmarkSizes are the operand sizes in bits.
+var benchmarkSizes = []int{64, 256, 1024, 4096, 65536, 1 << 20}
+
+// benchmarkOperation runs the same operation on BigNumber and on math/big.
+type benchmarkOperation struct {
+	name      string
+	bigNumber func(a, b *bignumbers.BigNumber)
+	mathBig   func(z, a, b *big.Int)
+}
+
+// benchmarkOperands returns two pseudo-random operands of the given size with a > b,
+// where b is a few bits shorter.
+func benchmarkOperands(bits int) (a, b bignumbers.BigNumber, bigA, bigB *big.Int) {
+	rng := rand.New(rand.NewSource(int64(bits)))
+	bytes := make([]byte, bits/8)
+	rng.Read(bytes)
+	bytes[0] |= 0x80
+	bigA = new(big.Int).SetBytes(bytes)
+	rng.Read(bytes)
+	bigB = new(big.Int).Rsh(new(big.Int).SetBytes(bytes), 4)
+	bigB.SetBit(bigB, bits-5, 1)
+	a.SetBytes(bigA.Bytes())
+	b.SetBytes(bigB.Bytes())
+	return
+}
+
+var benchmarkOperations = []benchmarkOperation{
+	{
+		name:      "ADD",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.ADD(*b) },
+		mathBig:   func(z, a, b *big.Int) { z.Add(a, b) },
+	},
+	{
+		name:      "SUB",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.SUB(*b) },
+		mathBig:   func(z, a, b *big.Int) { z.Sub(a, b) },
+	},
+	{
+		name:      "MOD",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.MOD(*b) },
+		mathBig:   func(z, a, b *big.Int) { z.Mod(a, b) },
+	},
+	{
+		name:      "MUL",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.MUL(*b) },
+		mathBig:   func(z, a, b *big.Int) { z.Mul(a, b) },
+	},
+	{
+		name:      "DIV",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.DIV(*b) },
+		mathBig:   func(z, a, b *big.Int) { z.Div(a, b) },
+	},
+	{
+		name:      "XOR",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.XOR(*b) },
+		mathBig:   func(z, a, b *big.Int) { z.Xor(a, b) },
+	},
+	{
+		name:      "AND",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.AND(*b) },
+		mathBig:   func(z, a, b *big.Int) { z.And(a, b) },
+	},
+	{
+		name:      "OR",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.OR(*b) },
+		mathBig:   func(z, a, b *big.Int) { z.Or(a, b) },
+	},
+	{
+		name:      "INV",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.Invert() },
+		mathBig: func(z, a, b *big.Int) {
+			z.Lsh(bigOne, uint(a.BitLen()))
+			z.Xor(a, z.Sub(z, bigOne))
+		},
+	},
+	{
+		name:      "ShiftL",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.ShiftL(67) },
+		mathBig:   func(z, a, b *big.Int) { z.Lsh(a, 67) },
+	},
+	{
+		name:      "ShiftR",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.ShiftR(67) },
+		mathBig:   func(z, a, b *big.Int) { z.Rsh(a, 67) },
+	},
+	{
+		name:      "GetHex",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.GetHex() },
+		mathBig:   func(z, a, b *big.Int) { a.Text(16) },
+	},
+	{
+		name:      "GetDecimal",
+		bigNumber: func(a, b *bignumbers.BigNumber) { a.GetDecimal() },
+		mathBig:   func(z, a, b *big.Int) { a.Text(10) },
+	},
+	{
+		name:      "InPlaceAdd",
+		bigNumber: func(a, b *bignumbers.BigNumber) { inPlaceResult.Add(a, b) },
+		mathBig:   func(z, a, b *big.Int) { z.Add(a, b) },
+	},
+	{
+		name:      "InPlaceXor",
+		bigNumber: func(a, b *bignumbers.BigNumber) { inPlaceResult.Xor(a, b) },
+		mathBig:   func(z, a, b *big.Int) { z.Xor(a, b) },
+	},
+}
+
+// bigOne is the math/big constant 1, shared so that the baseline does not allocate it.
+var bigOne = big.NewInt(1)
+
+// inPlaceResult is the destination of the in-place benchmarks, reused across iterations.
+var inPlaceResult bignumbers.BigNumber
+
+// BenchmarkOperations benchmarks every operation for every size, once for BigNumber and once for math/big.
+// Pass its output to cmd/benchreport to get a comparison table.
+func BenchmarkOperations(b *testing.B) {
+	for _, op := range benchmarkOperations {
+		for _, size := range benchmarkSizes {
+			a, c, bigA, bigC := benchmarkOperands(size)
+			b.Run(fmt.Sprintf("%s/bits=%d/BigNumber", op.name, size), func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					op.bigNumber(&a, &c)
+				}
+			})
+			b.Run(fmt.Sprintf("%s/bits=%d/big", op.name, size), func(b *testing.B) {
+				z := new(big.Int)
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					op.mathBig(z, bigA, bigC)
+				}
+			})
+		}
+	}
+}
+
+func TestBenchmarkOperations(t *testing.T) {
+	for _, op := range benchmarkOperations {
+		a, c, bigA, bigC := benchmarkOperands(256)
+		if a.GetHex() != hexOf(bigA) || c.GetHex() != hexOf(bigC) || !c.LessThan(a) {
+			t.Fatalf("benchmark operands for %s do not match", op.name)
+		}
+		op.bigNumber(&a, &c)
+		op.mathBig(new(big.Int), bigA, bigC)
+	}
+}
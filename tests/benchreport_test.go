@@ -0,0 +1,50 @@
+package bignumbers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/danielost/big-numbers/src/benchreport"
+)
+
+func TestBenchreport(t *testing.T) {
+	output := `goos: linux
+goarch: amd64
+pkg: github.com/danielost/big-numbers/tests
+BenchmarkOperations/XOR/bits=256/BigNumber-8   	 1000	       200.0 ns/op	      56 B/op	       3 allocs/op
+BenchmarkOperations/XOR/bits=256/big-8         	 1000	       100.0 ns/op	      48 B/op	       1 allocs/op
+BenchmarkOperations/XOR/bits=64/BigNumber-8    	 1000	       150.0 ns/op	       8 B/op	       1 allocs/op
+BenchmarkOperations/XOR/bits=64/big-8          	 1000	        50.0 ns/op	       8 B/op	       1 allocs/op
+BenchmarkOperations/XOR/bits=64/BigNumber-8    	 1000	       250.0 ns/op	       8 B/op	       1 allocs/op
+BenchmarkOperations/InPlaceAdd/bits=64/BigNumber-8 	 1000	        10.0 ns/op	       0 B/op	       0 allocs/op
+BenchmarkOperations/InPlaceAdd/bits=64/big-8       	 1000	        20.0 ns/op	       0 B/op	       0 allocs/op
+BenchmarkOperations/MOD/bits=64/BigNumber-8        	 1000	        10.0 ns/op	       2 B/op	       2 allocs/op
+BenchmarkOperations/MOD/bits=64/big-8              	 1000	        20.0 ns/op	       0 B/op	       0 allocs/op
+BenchmarkOperations/SUB/bits=64/BigNumber          	 1000	        30.0 ns/op
+BenchmarkBigNumber_Add/ADD-8                       	 1000	       196.3 ns/op	      56 B/op	       3 allocs/op
+PASS
+`
+	rows, err := benchreport.Parse(strings.NewReader(output))
+	if err != nil {
+		t.Fatalf("benchreport.Parse() error: %v", err)
+	}
+	var sb strings.Builder
+	if err := benchreport.WriteMarkdown(&sb, rows); err != nil {
+		t.Fatalf("benchreport.WriteMarkdown() error: %v", err)
+	}
+	expected := `| Operation | Bits | BigNumber ns/op | math/big ns/op | ns/op ratio | BigNumber allocs/op | math/big allocs/op | allocs/op ratio |
+|---|---:|---:|---:|---:|---:|---:|---:|
+| XOR | 64 | 200 | 50 | 4.00x | 1 | 1 | 1.00x |
+| XOR | 256 | 200 | 100 | 2.00x | 3 | 1 | 3.00x |
+| InPlaceAdd | 64 | 10 | 20 | 0.50x | 0 | 0 | 1.00x |
+| MOD | 64 | 10 | 20 | 0.50x | 2 | 0 | ∞ |
+| SUB | 64 | 30 | n/a | n/a | 0 | n/a | n/a |
+`
+	if sb.String() != expected {
+		t.Errorf("benchreport.WriteMarkdown() error: expected\n%s\nbut got\n%s", expected, sb.String())
+	}
+
+	if _, err := benchreport.Parse(strings.NewReader("BenchmarkOperations/XOR/size=64/big-8 1 1 ns/op\n")); err == nil {
+		t.Errorf("benchreport.Parse() expected an error for an unexpected benchmark name")
+	}
+}
@@ -1,6 +1,7 @@
 package bignumbers_test
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 
@@ -39,6 +40,102 @@ func TestBigNumber_SetHex(t *testing.T) {
 	}
 }
 
+func TestBigNumber_SetHexSyntax(t *testing.T) {
+	tests := []struct {
+		name        string
+		hex         string
+		expectedHex string
+		wantErr     bool
+	}{
+		{name: "Prefix", hex: "0x1abc0000000dddddddddddddd0000ffffffff003", expectedHex: "1abc0000000dddddddddddddd0000ffffffff003"},
+		{name: "Separators across blocks", hex: "0x1abc_0000000d_dddddddd_ddddd000_0fffffff_f003", expectedHex: "1abc0000000dddddddddddddd0000ffffffff003"},
+		{name: "Prefix only", hex: "0x", expectedHex: ""},
+		{name: "Misplaced separator", hex: "0x1abc__0000", wantErr: true},
+		{name: "Prefix inside", hex: "ff0xff", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			err := bn.SetHex(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BigNumber.SetHex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && bn.GetHex() != tt.expectedHex {
+				t.Errorf("BigNumber.SetHex() error: expected %s but got %s", tt.expectedHex, bn.GetHex())
+			}
+		})
+	}
+}
+
+// parseLiteral parses s with math/big using the Go literal syntax, adding prefix if s has none.
+// An empty literal is zero, as it is for BigNumber.
+func parseLiteral(s, prefix string) (*big.Int, bool) {
+	if !strings.HasPrefix(strings.ToLower(s), prefix) {
+		if strings.HasPrefix(s, "_") {
+			return nil, false
+		}
+		s = prefix + s
+	}
+	if len(s) == len(prefix) {
+		return new(big.Int), true
+	}
+	return new(big.Int).SetString(s, 0)
+}
+
+func FuzzBigNumber_SetHex(f *testing.F) {
+	f.Add("1abc0000000dddddddddddddd0000ffffffff003")
+	f.Add("0x33ced2c76b26cae94e162c4c0d2c0ff7c13094b0185a3c122e732d5ba77efebc")
+	f.Add("0xFFFF_FFFF_FFFF_FFFF_FFFF")
+	f.Add("0000000000000000000000001")
+	f.Add("12g4")
+	f.Fuzz(func(t *testing.T, hex string) {
+		var bn bignumbers.BigNumber
+		err := bn.SetHex(hex)
+		expected, ok := parseLiteral(hex, "0x")
+		if (err == nil) != ok {
+			t.Fatalf("BigNumber.SetHex(%q) error = %v, but math/big accepted it: %v", hex, err, ok)
+		}
+		if !ok {
+			return
+		}
+		if bn.GetHex() != hexOf(expected) {
+			t.Fatalf("BigNumber.SetHex(%q) error: expected %s but got %s", hex, hexOf(expected), bn.GetHex())
+		}
+		var parsed bignumbers.BigNumber
+		if err := parsed.SetHex(bn.GetHex()); err != nil || parsed.GetHex() != bn.GetHex() {
+			t.Fatalf("BigNumber.GetHex() round trip error: expected %s but got %s (%v)", bn.GetHex(), parsed.GetHex(), err)
+		}
+	})
+}
+
+func FuzzBigNumber_SetBinary(f *testing.F) {
+	f.Add("1010101111001101111011110000000100100011010001010110011110001001111111101101110010111010100110000111011001010100001100100001000")
+	f.Add("0b1111_0000")
+	f.Add("1012")
+	f.Fuzz(func(t *testing.T, binary string) {
+		var bn bignumbers.BigNumber
+		err := bn.SetBinary(binary)
+		expected, ok := parseLiteral(binary, "0b")
+		if (err == nil) != ok {
+			t.Fatalf("BigNumber.SetBinary(%q) error = %v, but math/big accepted it: %v", binary, err, ok)
+		}
+		if !ok {
+			return
+		}
+		expectedBinary := ""
+		if expected.Sign() != 0 {
+			expectedBinary = expected.Text(2)
+		}
+		if bn.GetBinary() != expectedBinary {
+			t.Fatalf("BigNumber.SetBinary(%q) error: expected %s but got %s", binary, expectedBinary, bn.GetBinary())
+		}
+		var parsed bignumbers.BigNumber
+		if err := parsed.SetBinary(bn.GetBinary()); err != nil || parsed.GetBinary() != bn.GetBinary() {
+			t.Fatalf("BigNumber.GetBinary() round trip error: expected %s but got %s (%v)", bn.GetBinary(), parsed.GetBinary(), err)
+		}
+	})
+}
+
 func TestBigNumber_GetHex(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -105,6 +202,9 @@ func TestBigNumber_Invert(t *testing.T) {
 	}{
 		{name: "Invert #1", hex: "1abc0000000dddddddddddddd0000ffffffff003", expectedHex: "E543FFFFFFF22222222222222FFFF00000000FFC"},
 		{name: "Invert #2", hex: "33ced2c76b26cae94e162c4c0d2c0ff7c13094b0185a3c122e732d5ba77efebc", expectedHex: "cc312d3894d93516b1e9d3b3f2d3f0083ecf6b4fe7a5c3edd18cd2a458810143"},
+		{name: "Leading zero nibble", hex: "f0f", expectedHex: "f0"},
+		{name: "All ones", hex: "ffffffffffffffff", expectedHex: ""},
+		{name: "Top block all ones", hex: "ffffffffffffffff0000000000000001", expectedHex: "fffffffffffffffe"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -239,6 +339,7 @@ func TestBigNumber_ADD(t *testing.T) {
 		{name: "ADD #2", left: "36f028580bb02cc8272a9a020f4200e346e276ae664e45ee80745574e2f5ab80", right: "be024579b3f1357908641fdb97ffffffacf13468ad44444497530eca86ffffff", expectedHex: "F4F26DD1BFA162412F8EB9DDA74200E2F3D3AB1713928A3317C7643F69F5AB7F"},
 		{name: "ADD #3", left: "10", right: "20", expectedHex: "30"},
 		{name: "ADD #4", left: "FFFFFFFFFFFFFFFF", right: "1", expectedHex: "10000000000000000"},
+		{name: "ADD #5", left: "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF", right: "FFFFFFFFFFFFFFFF0000000000000001", expectedHex: "10000000000000000FFFFFFFFFFFFFFFF0000000000000000"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -264,6 +365,7 @@ func TestBigNumber_SUB(t *testing.T) {
 		{name: "SUB #1", left: "33ced2c76b26cae94e162c4c0d2c0ff7c13094b0185a3c122e732d5ba77efebc", right: "22e962951cb6cd2ce279ab0e2095825c141d48ef3ca9dabf253e38760b57fe03", expectedHex: "10e570324e6ffdbc6b9c813dec968d9bad134bc0dbb061530934f4e59c2700b9", wantErr: false},
 		{name: "SUB #2", left: "10abcdef0123456789fedcba9876543210", right: "b97ffffffacf13468ad44444497530eca86ffffff", expectedHex: "", wantErr: true},
 		{name: "SUB #3", left: "abcdef0123456789fedcba9876543210abcdef0123456789fedcba9876543210", right: "1234567890abcdef0987654321abcdef0123456789fedcba9876543210abcdef", expectedHex: "999998889299999AF555555554A86421AAAAA99999468ACF6666666665A86421", wantErr: false},
+		{name: "SUB #4", left: "1000000000000000000000000000000000000000000000000", right: "FFFFFFFFFFFFFFFF0000000000000001", expectedHex: "FFFFFFFFFFFFFFFF0000000000000000FFFFFFFFFFFFFFFF", wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
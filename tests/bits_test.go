@@ -0,0 +1,134 @@
+package bignumbers_test
+
+import (
+	"math/big"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+var bitTestValues = []string{
+	"0",
+	"1",
+	"8000000000000000",
+	"10000000000000000",
+	"1abc0000000dddddddddddddd0000ffffffff003",
+	"51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4",
+}
+
+func bigFromHex(hex string) *big.Int {
+	if hex == "" {
+		return new(big.Int)
+	}
+	value, _ := new(big.Int).SetString(hex, 16)
+	return value
+}
+
+func TestBigNumber_Bit(t *testing.T) {
+	for _, hex := range bitTestValues {
+		var bn bignumbers.BigNumber
+		bn.SetHex(hex)
+		expected := bigFromHex(hex)
+		for i := 0; i < 300; i++ {
+			if bit := bn.Bit(i); bit != expected.Bit(i) {
+				t.Errorf("BigNumber.Bit(%d) of %s error: expected %d but got %d", i, hex, expected.Bit(i), bit)
+			}
+		}
+	}
+}
+
+func TestBigNumber_SetBit(t *testing.T) {
+	indices := []int{0, 1, 63, 64, 65, 127, 200}
+	for _, hex := range bitTestValues {
+		for _, i := range indices {
+			for _, v := range []uint{0, 1} {
+				var bn bignumbers.BigNumber
+				bn.SetHex(hex)
+				original := bn.GetBlocks()
+				bn.SetBit(i, v)
+				expected := new(big.Int).SetBit(bigFromHex(hex), i, v)
+				if bn.GetHex() != hexOf(expected) {
+					t.Errorf("BigNumber.SetBit(%d, %d) of %s error: expected %s but got %s", i, v, hex, hexOf(expected), bn.GetHex())
+				}
+				var copyOfOriginal bignumbers.BigNumber
+				copyOfOriginal.SetBlocks(original)
+				if copyOfOriginal.GetHex() != hexOf(bigFromHex(hex)) {
+					t.Errorf("BigNumber.SetBit(%d, %d) of %s modified the shared blocks", i, v, hex)
+				}
+			}
+
+			var cleared, flipped bignumbers.BigNumber
+			cleared.SetHex(hex)
+			flipped.SetHex(hex)
+			cleared.ClearBit(i)
+			flipped.FlipBit(i)
+			expectedCleared := new(big.Int).SetBit(bigFromHex(hex), i, 0)
+			expectedFlipped := new(big.Int).SetBit(bigFromHex(hex), i, bigFromHex(hex).Bit(i)^1)
+			if cleared.GetHex() != hexOf(expectedCleared) {
+				t.Errorf("BigNumber.ClearBit(%d) of %s error: expected %s but got %s", i, hex, hexOf(expectedCleared), cleared.GetHex())
+			}
+			if flipped.GetHex() != hexOf(expectedFlipped) {
+				t.Errorf("BigNumber.FlipBit(%d) of %s error: expected %s but got %s", i, hex, hexOf(expectedFlipped), flipped.GetHex())
+			}
+		}
+	}
+}
+
+func TestBigNumber_BitCounts(t *testing.T) {
+	tests := []struct {
+		name                  string
+		hex                   string
+		expectedBitLen        int
+		expectedTrailingZeros int
+		expectedLeadingZeros  int
+		expectedPopCount      int
+	}{
+		{name: "Zero", hex: "0", expectedBitLen: 0, expectedTrailingZeros: 0, expectedLeadingZeros: 256, expectedPopCount: 0},
+		{name: "One", hex: "1", expectedBitLen: 1, expectedTrailingZeros: 0, expectedLeadingZeros: 255, expectedPopCount: 1},
+		{name: "Block boundary", hex: "10000000000000000", expectedBitLen: 65, expectedTrailingZeros: 64, expectedLeadingZeros: 191, expectedPopCount: 1},
+		{name: "Full width", hex: "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4", expectedBitLen: 255, expectedTrailingZeros: 2, expectedLeadingZeros: 1, expectedPopCount: 135},
+		{name: "Too wide", hex: "1ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", expectedBitLen: 257, expectedTrailingZeros: 0, expectedLeadingZeros: -1, expectedPopCount: 257},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			if got := bn.BitLen(); got != tt.expectedBitLen {
+				t.Errorf("BigNumber.BitLen() error: expected %d but got %d", tt.expectedBitLen, got)
+			}
+			if got := bn.TrailingZeros(); got != tt.expectedTrailingZeros {
+				t.Errorf("BigNumber.TrailingZeros() error: expected %d but got %d", tt.expectedTrailingZeros, got)
+			}
+			if got := bn.LeadingZeros(256); got != tt.expectedLeadingZeros {
+				t.Errorf("BigNumber.LeadingZeros(256) error: expected %d but got %d", tt.expectedLeadingZeros, got)
+			}
+			if got := bn.PopCount(); got != tt.expectedPopCount {
+				t.Errorf("BigNumber.PopCount() error: expected %d but got %d", tt.expectedPopCount, got)
+			}
+		})
+	}
+}
+
+func TestBigNumber_Extract(t *testing.T) {
+	ranges := [][2]int{{0, 0}, {0, 1}, {0, 64}, {3, 67}, {60, 130}, {64, 128}, {100, 400}, {300, 400}, {1, 255}}
+	for _, hex := range bitTestValues {
+		var bn bignumbers.BigNumber
+		bn.SetHex(hex)
+		for _, r := range ranges {
+			expected := new(big.Int).Rsh(bigFromHex(hex), uint(r[0]))
+			mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(r[1]-r[0])), big.NewInt(1))
+			expected.And(expected, mask)
+			if result := bn.Extract(r[0], r[1]); result.GetHex() != hexOf(expected) {
+				t.Errorf("BigNumber.Extract(%d, %d) of %s error: expected %s but got %s", r[0], r[1], hex, hexOf(expected), result.GetHex())
+			}
+		}
+	}
+}
+
+// hexOf returns the hex representation of a big.Int in the format used by BigNumber.GetHex.
+func hexOf(value *big.Int) string {
+	if value.Sign() == 0 {
+		return ""
+	}
+	return value.Text(16)
+}
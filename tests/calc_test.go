@@ -0,0 +1,122 @@
+package bignumbers_test
+
+import (
+	"errors"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+	"github.com/danielost/big-numbers/src/calc"
+)
+
+func TestCalc_Eval(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		expectedHex string
+	}{
+		{name: "Literal", expr: "0xff", expectedHex: "ff"},
+		{name: "Mixed bases", expr: "0xff + 0b1 + 10", expectedHex: "10a"},
+		{name: "Precedence", expr: "(0xff ^ 0b1010) << 3 + 12345 % 7", expectedHex: "7ac"},
+		{name: "Left associative", expr: "100 - 10 - 1", expectedHex: "59"},
+		{name: "Parentheses", expr: "100 - (10 - 1)", expectedHex: "5b"},
+		{name: "AND binds tighter than OR", expr: "0xf0 | 0xff & 0x0f", expectedHex: "ff"},
+		{name: "Invert", expr: "~0xf0", expectedHex: "f"},
+		{name: "Invert caret", expr: "^0b1010 & 0xf", expectedHex: "5"},
+		{name: "Shift right", expr: "0x51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4 >> 3", expectedHex: "a37ec108295aae4d47837d8131eef636a9ff64f0ff1aa514e983affbcc8e1d4"},
+		{name: "Shift right everything", expr: "0xff >> 100", expectedHex: ""},
+		{name: "Carry", expr: "0xffffffffffffffff + 1", expectedHex: "10000000000000000"},
+		{name: "Multiplication", expr: "0xabcdef0123456789fedcba9876543210 * 0x1234567890abcdef", expectedHex: "c379aaab89f98f62c83d32a87af3529236d88fe55618cf0"},
+		{name: "Division", expr: "0xabcdef0123456789fedcba9876543210 / 0x1234567890abcdef", expectedHex: "96ffff10537fef84a"},
+		{name: "Multiplicative left associative", expr: "100 / 10 * 3 % 7", expectedHex: "2"},
+		{name: "Multiplication binds tighter than addition", expr: "2 + 3 * 4", expectedHex: "e"},
+		{name: "Big modulo", expr: "0xabcdef0123456789fedcba9876543210abcdef0123456789fedcba9876543210 % 0x1234567890abcdef0987654321abcdef0123456789fedcba9876543210abcdef", expectedHex: "7f6e4c40d3b2a22a91a2b3c4749f4a9a1907e5d494fa4faa2b3c4d5e049f4a9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := calc.Eval(tt.expr)
+			if err != nil {
+				t.Fatalf("calc.Eval() error: %v", err)
+			}
+			if result.GetHex() != tt.expectedHex {
+				t.Errorf("calc.Eval() error: expected %s but got %s", tt.expectedHex, result.GetHex())
+			}
+		})
+	}
+}
+
+func TestCalc_EvalErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		expr           string
+		expectedOffset int
+		expectedError  string
+	}{
+		{name: "Empty", expr: "", expectedOffset: 0, expectedError: "col 1: unexpected end of expression"},
+		{name: "Unexpected character", expr: "1 + $", expectedOffset: 4, expectedError: "col 5: unexpected character '$'"},
+		{name: "Invalid literal", expr: "1 + 0xfg", expectedOffset: 4, expectedError: `col 5: invalid number "0xfg": parsing "0xfg": offset 3: 'g' is not a base 16 digit`},
+		{name: "Unclosed parenthesis", expr: "(1 + 2", expectedOffset: 6, expectedError: `col 7: expected ")" to close "(" at col 1`},
+		{name: "Trailing token", expr: "1 2", expectedOffset: 2, expectedError: `col 3: unexpected "2"`},
+		{name: "Binary inversion", expr: "2 ~ 3", expectedOffset: 2, expectedError: `col 3: unexpected "~"`},
+		{name: "Negative literal", expr: "-1", expectedOffset: 0, expectedError: "col 1: negative numbers are not supported"},
+		{name: "Negative result", expr: "1 - 2", expectedOffset: 2, expectedError: "col 3: cannot subtract: sub result is negative"},
+		{name: "Division by zero", expr: "5 % (1 - 1)", expectedOffset: 2, expectedError: "col 3: division by zero"},
+		{name: "Integer division by zero", expr: "5 / 0", expectedOffset: 2, expectedError: "col 3: division by zero"},
+		{name: "Huge shift", expr: "1 << 0xffffffffffffffffff", expectedOffset: 5, expectedError: "col 6: shift amount 4722366482869645213695 is too large"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calc.Eval(tt.expr)
+			var calcErr *calc.Error
+			if !errors.As(err, &calcErr) {
+				t.Fatalf("calc.Eval() error: expected *calc.Error but got %v", err)
+			}
+			if calcErr.Offset != tt.expectedOffset {
+				t.Errorf("calc.Eval() error offset: expected %d but got %d", tt.expectedOffset, calcErr.Offset)
+			}
+			if err.Error() != tt.expectedError {
+				t.Errorf("calc.Eval() error message: expected %q but got %q", tt.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestCalc_InvalidLiteralCause(t *testing.T) {
+	_, err := calc.Eval("1 + 12a")
+	var parseErr *bignumbers.ParseError
+	if !errors.Is(err, bignumbers.ErrInvalidDigit) || !errors.As(err, &parseErr) {
+		t.Fatalf("calc.Eval() error: expected a ParseError wrapping ErrInvalidDigit but got %v", err)
+	}
+	if parseErr.Offset != 2 || parseErr.Base != 10 {
+		t.Errorf("calc.Eval() error: expected offset 2 in base 10 but got offset %d in base %d", parseErr.Offset, parseErr.Base)
+	}
+}
+
+func TestCalc_Parse(t *testing.T) {
+	node, err := calc.Parse("1 + 2 << 3")
+	if err != nil {
+		t.Fatalf("calc.Parse() error: %v", err)
+	}
+	root, ok := node.(*calc.Binary)
+	if !ok || root.Op != "+" || root.Pos() != 2 {
+		t.Fatalf("calc.Parse() error: expected \"+\" at the root but got %#v", node)
+	}
+	if shift, ok := root.Right.(*calc.Binary); !ok || shift.Op != "<<" {
+		t.Errorf("calc.Parse() error: expected \"<<\" on the right but got %#v", root.Right)
+	}
+}
+
+func TestCalc_EvalVars(t *testing.T) {
+	var a bignumbers.BigNumber
+	a.SetHex("ff")
+	vars := calc.Vars{"a": a, "_b1": a.ShiftL(4)}
+	result, err := calc.EvalVars("a ^ _b1", vars)
+	if err != nil {
+		t.Fatalf("calc.EvalVars() error: %v", err)
+	}
+	if result.GetHex() != "f0f" {
+		t.Errorf("calc.EvalVars() error: expected f0f but got %s", result.GetHex())
+	}
+	if _, err := calc.Eval("a + 1"); err == nil || err.Error() != `col 1: undefined variable "a"` {
+		t.Errorf("calc.Eval() error: expected an undefined variable error but got %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package bignumbers_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/danielost/big-numbers/src/cli"
+)
+
+func TestCli_Run(t *testing.T) {
+	tests := []struct {
+		name             string
+		args             []string
+		stdin            string
+		expectedStdout   string
+		expectedExitCode int
+		expectedStderr   string
+	}{
+		{name: "Add", args: []string{"add", "0x36f028580bb02cc8272a9a020f4200e346e276ae664e45ee80745574e2f5ab80", "0x70983d692f648185febe6d6fa607630ae68649f7e6fc45b94680096c06e4fadb"}, expectedStdout: "0xa78865c13b14ae4e25e90771b54963ee2d68c0a64d4a8ba7c6f45ee0e9daa65b\n"},
+		{name: "Add many", args: []string{"--out", "dec", "add", "1", "0x2", "0b11"}, expectedStdout: "6\n"},
+		{name: "Out after command", args: []string{"add", "--out=bin", "1", "2"}, expectedStdout: "0b11\n"},
+		{name: "Sub", args: []string{"sub", "0x10", "1"}, expectedStdout: "0xf\n"},
+		{name: "Sub negative", args: []string{"sub", "1", "2"}, expectedExitCode: 1, expectedStderr: "bn sub: sub result is negative\n"},
+		{name: "Mod", args: []string{"--out", "dec", "mod", "12345", "7"}, expectedStdout: "4\n"},
+		{name: "Mod by zero", args: []string{"mod", "1", "0"}, expectedExitCode: 1, expectedStderr: "bn mod: division by zero\n"},
+		{name: "Mul", args: []string{"--out", "dec", "mul", "18446744073709551616", "3", "0b10"}, expectedStdout: "110680464442257309696\n"},
+		{name: "Div", args: []string{"--out", "dec", "div", "110680464442257309696", "6"}, expectedStdout: "18446744073709551616\n"},
+		{name: "Div by zero", args: []string{"div", "1", "0"}, expectedExitCode: 1, expectedStderr: "bn div: division by zero\n"},
+		{name: "Powmod", args: []string{"--out", "dec", "powmod", "4", "13", "497"}, expectedStdout: "445\n"},
+		{name: "Powmod by zero", args: []string{"powmod", "4", "13", "0"}, expectedExitCode: 1, expectedStderr: "bn powmod: division by zero\n"},
+		{name: "Powmod operand count", args: []string{"powmod", "4", "13"}, expectedExitCode: 1, expectedStderr: "bn powmod: expected 3 operands but got 2\n"},
+		{name: "Xor", args: []string{"xor", "0xff", "0b1010"}, expectedStdout: "0xf5\n"},
+		{name: "And", args: []string{"and", "0xff", "0x0f"}, expectedStdout: "0xf\n"},
+		{name: "Or", args: []string{"or", "0xf0", "0x0f"}, expectedStdout: "0xff\n"},
+		{name: "Inv", args: []string{"inv", "0xf0", "0xa"}, expectedStdout: "0xf\n0x5\n"},
+		{name: "Shl", args: []string{"shl", "0xff", "64"}, expectedStdout: "0xff0000000000000000\n"},
+		{name: "Shr", args: []string{"--out", "oct", "shr", "0xff", "4"}, expectedStdout: "0o17\n"},
+		{name: "Invalid shift", args: []string{"shr", "0xff", "-1"}, expectedExitCode: 1, expectedStderr: "bn shr: invalid shift amount \"-1\"\n"},
+		{name: "Eval", args: []string{"--out", "dec", "eval", "(0xff ^ 0b1010) << 3 + 12345 % 7"}, expectedStdout: "1964\n"},
+		{name: "Conv", args: []string{"--out", "dec", "conv", "0x10000000000000000"}, expectedStdout: "18446744073709551616\n"},
+		{name: "Stdin operands", args: []string{"xor"}, stdin: "0xff\n0b1010\n", expectedStdout: "0xf5\n"},
+		{name: "Stdin expression", args: []string{"eval"}, stdin: "1 +\n 2\n", expectedStdout: "0x3\n"},
+		{name: "Invalid operand", args: []string{"add", "1", "0xzz"}, expectedExitCode: 1, expectedStderr: "bn add: invalid operand \"0xzz\"\n"},
+		{name: "Prefix without digits", args: []string{"add", "0x", "1"}, expectedExitCode: 1, expectedStderr: "bn add: invalid operand \"0x\"\n"},
+		{name: "Too few operands", args: []string{"add", "1"}, expectedExitCode: 1, expectedStderr: "bn add: expected at least 2 operands but got 1\n"},
+		{name: "Unknown output base", args: []string{"--out", "b64", "add", "1", "2"}, expectedExitCode: 2, expectedStderr: "bn: unknown output base \"b64\"\n"},
+		{name: "Unknown command", args: []string{"pow", "1", "2"}, expectedExitCode: 2},
+		{name: "No command", args: []string{}, expectedExitCode: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := cli.Run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if code != tt.expectedExitCode {
+				t.Fatalf("cli.Run() exit code: expected %d but got %d (stderr: %s)", tt.expectedExitCode, code, stderr.String())
+			}
+			if stdout.String() != tt.expectedStdout {
+				t.Errorf("cli.Run() stdout: expected %q but got %q", tt.expectedStdout, stdout.String())
+			}
+			if tt.expectedStderr != "" && stderr.String() != tt.expectedStderr {
+				t.Errorf("cli.Run() stderr: expected %q but got %q", tt.expectedStderr, stderr.String())
+			}
+		})
+	}
+}
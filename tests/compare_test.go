@@ -0,0 +1,128 @@
+package bignumbers_test
+
+import (
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func isCanonical(bn bignumbers.BigNumber) bool {
+	blocks := bn.GetBlocks()
+	return len(blocks) == 0 || blocks[len(blocks)-1].GetDecimal() != 0
+}
+
+func TestBigNumber_Canonical(t *testing.T) {
+	var a, allOnes bignumbers.BigNumber
+	a.SetHex("51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4")
+	allOnes.SetHex("ffffffffffffffffffffffffffffffff")
+	produce := map[string]func() bignumbers.BigNumber{
+		"SetHex zero":          func() (bn bignumbers.BigNumber) { bn.SetHex("0"); return },
+		"SetHex leading zeros": func() (bn bignumbers.BigNumber) { bn.SetHex("000000000000000000000000000000001"); return },
+		"SetBinary zero":       func() (bn bignumbers.BigNumber) { bn.SetBinary("0"); return },
+		"SetDecimal zero":      func() (bn bignumbers.BigNumber) { bn.SetDecimal("0000"); return },
+		"SetBytes zero":        func() (bn bignumbers.BigNumber) { bn.SetBytes([]byte{0, 0, 0}); return },
+		"SetBlocks": func() (bn bignumbers.BigNumber) {
+			bn.SetBlocks([]bignumbers.Uint{{Value: 1}, {Value: 0}, {Value: 0}})
+			return
+		},
+		"SUB to zero":       func() bignumbers.BigNumber { diff, _ := a.SUB(a); return diff },
+		"SUB high blocks":   func() bignumbers.BigNumber { diff, _ := a.SUB(a.ShiftR(64)); return diff },
+		"XOR to zero":       func() bignumbers.BigNumber { return a.XOR(a) },
+		"AND with zero":     func() bignumbers.BigNumber { return a.AND(bignumbers.BigNumber{}) },
+		"ADD zeros":         func() bignumbers.BigNumber { var zero bignumbers.BigNumber; return zero.ADD(zero) },
+		"Invert all ones":   func() bignumbers.BigNumber { return allOnes.Invert() },
+		"Invert":            func() bignumbers.BigNumber { return a.Invert() },
+		"InvertWidth":       func() bignumbers.BigNumber { return allOnes.InvertWidth(192) },
+		"MOD to zero":       func() bignumbers.BigNumber { return a.MOD(a) },
+		"ShiftL zero":       func() bignumbers.BigNumber { var zero bignumbers.BigNumber; return zero.ShiftL(100) },
+		"ShiftR everything": func() bignumbers.BigNumber { return a.ShiftR(300) },
+		"ClearBit":          func() bignumbers.BigNumber { bn := a; bn.ClearBit(254); bn.SetBit(300, 1); bn.ClearBit(300); return bn },
+		"Extract":           func() bignumbers.BigNumber { return a.Extract(64, 200) },
+		"In-place Sub":      func() bignumbers.BigNumber { var bn bignumbers.BigNumber; bn.Sub(&a, &a); return bn },
+	}
+	for name, f := range produce {
+		t.Run(name, func(t *testing.T) {
+			if bn := f(); !isCanonical(bn) {
+				t.Errorf("%s produced non-canonical blocks %v", name, bn.GetBlocks())
+			}
+		})
+	}
+}
+
+func TestBigNumber_Cmp(t *testing.T) {
+	tests := []struct {
+		name        string
+		left        string
+		right       string
+		expectedCmp int
+	}{
+		{name: "Zero and zero", left: "0", right: "", expectedCmp: 0},
+		{name: "Equal", left: "51bf608414ad5726a3c1bec098f77b1b", right: "51BF608414AD5726A3C1BEC098F77B1B", expectedCmp: 0},
+		{name: "Fewer blocks", left: "ffffffffffffffff", right: "10000000000000000", expectedCmp: -1},
+		{name: "More blocks", left: "10000000000000000", right: "ffffffffffffffff", expectedCmp: 1},
+		{name: "Low block differs", left: "10000000000000002", right: "10000000000000001", expectedCmp: 1},
+		{name: "High block differs", left: "10000000000000002", right: "20000000000000001", expectedCmp: -1},
+		{name: "Leading zeros", left: "000000000000000000000001", right: "1", expectedCmp: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var left, right bignumbers.BigNumber
+			left.SetHex(tt.left)
+			right.SetHex(tt.right)
+			if cmp := left.Cmp(right); cmp != tt.expectedCmp {
+				t.Errorf("BigNumber.Cmp() error: expected %d but got %d", tt.expectedCmp, cmp)
+			}
+			if cmp := right.Cmp(left); cmp != -tt.expectedCmp {
+				t.Errorf("BigNumber.Cmp() reversed error: expected %d but got %d", -tt.expectedCmp, cmp)
+			}
+			if equal := left.Equal(right); equal != (tt.expectedCmp == 0) {
+				t.Errorf("BigNumber.Equal() error: expected %v but got %v", tt.expectedCmp == 0, equal)
+			}
+			if less := left.LessThan(right); less != (tt.expectedCmp < 0) {
+				t.Errorf("BigNumber.LessThan() error: expected %v but got %v", tt.expectedCmp < 0, less)
+			}
+			if greater := left.GreaterThan(right); greater != (tt.expectedCmp > 0) {
+				t.Errorf("BigNumber.GreaterThan() error: expected %v but got %v", tt.expectedCmp > 0, greater)
+			}
+		})
+	}
+
+	var nonCanonical, one bignumbers.BigNumber
+	nonCanonical.AppendBlock(bignumbers.Uint{Value: 1})
+	nonCanonical.AppendBlock(bignumbers.Uint{Value: 0})
+	one.SetHex("1")
+	if !nonCanonical.Equal(one) || !nonCanonical.IsOne() {
+		t.Errorf("BigNumber.Equal() error: leading zero blocks from AppendBlock must be ignored")
+	}
+}
+
+func TestBigNumber_Predicates(t *testing.T) {
+	tests := []struct {
+		name         string
+		hex          string
+		expectedZero bool
+		expectedOne  bool
+		expectedSign int
+	}{
+		{name: "Empty", hex: "", expectedZero: true, expectedOne: false, expectedSign: 0},
+		{name: "Zero", hex: "0000", expectedZero: true, expectedOne: false, expectedSign: 0},
+		{name: "One", hex: "0001", expectedZero: false, expectedOne: true, expectedSign: 1},
+		{name: "Two", hex: "2", expectedZero: false, expectedOne: false, expectedSign: 1},
+		{name: "One in high block", hex: "10000000000000001", expectedZero: false, expectedOne: false, expectedSign: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			if bn.IsZero() != tt.expectedZero {
+				t.Errorf("BigNumber.IsZero() error: expected %v", tt.expectedZero)
+			}
+			if bn.IsOne() != tt.expectedOne {
+				t.Errorf("BigNumber.IsOne() error: expected %v", tt.expectedOne)
+			}
+			if bn.Sign() != tt.expectedSign {
+				t.Errorf("BigNumber.Sign() error: expected %d but got %d", tt.expectedSign, bn.Sign())
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package bignumbers_test
+
+import (
+	"sync"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestBigNumber_GetBlocksReturnsCopy(t *testing.T) {
+	var bn bignumbers.BigNumber
+	bn.SetHex("ffffffffffffffff0000000000000001")
+	blocks := bn.GetBlocks()
+	blocks[0] = bignumbers.Uint{Value: 42}
+	if hex := bn.GetHex(); hex != "ffffffffffffffff0000000000000001" {
+		t.Errorf("modifying GetBlocks() result changed the BigNumber to %s", hex)
+	}
+}
+
+func TestBigNumber_SetBlocksCopies(t *testing.T) {
+	blocks := []bignumbers.Uint{{Value: 1}, {Value: 2}}
+	var bn bignumbers.BigNumber
+	bn.SetBlocks(blocks)
+	blocks[1] = bignumbers.Uint{Value: 3}
+	if hex := bn.GetHex(); hex != "20000000000000001" {
+		t.Errorf("modifying the slice passed to SetBlocks() changed the BigNumber to %s", hex)
+	}
+}
+
+func TestBigNumber_Clone(t *testing.T) {
+	var original, one bignumbers.BigNumber
+	original.SetHex("ffffffffffffffffffffffffffffffff")
+	one.SetHex("1")
+	clone := original.Clone()
+	clone.Add(&clone, &one)
+	clone.SetBit(3, 0)
+	if hex := original.GetHex(); hex != "ffffffffffffffffffffffffffffffff" {
+		t.Errorf("modifying a clone changed the original to %s", hex)
+	}
+	if hex := clone.GetHex(); hex != "100000000000000000000000000000000" {
+		t.Errorf("BigNumber.Clone() error: expected 100000000000000000000000000000000 but got %s", hex)
+	}
+}
+
+// TestBigNumber_ConcurrentReads shares two values between many goroutines. Run it with -race.
+func TestBigNumber_ConcurrentReads(t *testing.T) {
+	var a, b bignumbers.BigNumber
+	a.SetHex("51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4")
+	b.SetHex("403db8ad88a3932a0b7e8189aed9eeffb8121dfac05c3512fdb396dd73f6331c")
+	expectedSum := a.ADD(b)
+	expectedDiff, _ := a.SUB(b)
+	expectedXor := a.XOR(b)
+	expectedShift := a.ShiftL(77)
+	expectedDecimal := a.GetDecimal()
+	expectedText, _ := b.MarshalText()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 32; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				if sum := a.ADD(b); !sum.Equal(expectedSum) {
+					t.Errorf("goroutine %d: ADD returned %s", g, sum.GetHex())
+				}
+				if diff, _ := a.SUB(b); !diff.Equal(expectedDiff) {
+					t.Errorf("goroutine %d: SUB returned %s", g, diff.GetHex())
+				}
+				if xor := a.XOR(b); !xor.Equal(expectedXor) {
+					t.Errorf("goroutine %d: XOR returned %s", g, xor.GetHex())
+				}
+				if shifted := a.ShiftL(77); !shifted.Equal(expectedShift) {
+					t.Errorf("goroutine %d: ShiftL returned %s", g, shifted.GetHex())
+				}
+				if decimal := a.GetDecimal(); decimal != expectedDecimal {
+					t.Errorf("goroutine %d: GetDecimal returned %s", g, decimal)
+				}
+				if text, _ := b.MarshalText(); string(text) != string(expectedText) {
+					t.Errorf("goroutine %d: MarshalText returned %s", g, text)
+				}
+				if a.Cmp(b) != 1 || a.BitLen() != 255 || a.Bit(g) != expectedShift.Bit(g+77) {
+					t.Errorf("goroutine %d: comparison or bit query returned a wrong result", g)
+				}
+
+				local := a.Clone()
+				local.Add(&local, &b)
+				local.SetBit(300+g, 1)
+				local.Xor(&local, &b)
+				blocks := b.GetBlocks()
+				blocks[0] = bignumbers.Uint{Value: uint64(g)}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if sum := a.ADD(b); !sum.Equal(expectedSum) {
+		t.Errorf("shared values were modified: a = %s, b = %s", a.GetHex(), b.GetHex())
+	}
+}
@@ -0,0 +1,127 @@
+package bignumbers_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestFromInt64(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       int64
+		expectedHex string
+		expectedErr error
+	}{
+		{name: "Zero", value: 0, expectedHex: ""},
+		{name: "One", value: 1, expectedHex: "1"},
+		{name: "Max", value: math.MaxInt64, expectedHex: "7fffffffffffffff"},
+		{name: "Negative", value: -1, expectedErr: bignumbers.ErrNegativeValue},
+		{name: "Min", value: math.MinInt64, expectedErr: bignumbers.ErrNegativeValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bn, err := bignumbers.FromInt64(tt.value)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("FromInt64() expected error %v but got %v", tt.expectedErr, err)
+			}
+			if hex := bn.GetHex(); hex != tt.expectedHex {
+				t.Errorf("FromInt64() error: expected %s but got %s", tt.expectedHex, hex)
+			}
+		})
+	}
+}
+
+func TestBigNumber_Uint64(t *testing.T) {
+	tests := []struct {
+		name          string
+		hex           string
+		expectedValue uint64
+		expectedExact bool
+	}{
+		{name: "Zero", hex: "", expectedValue: 0, expectedExact: true},
+		{name: "Max", hex: "ffffffffffffffff", expectedValue: math.MaxUint64, expectedExact: true},
+		{name: "Too large", hex: "10000000000000002", expectedValue: 2, expectedExact: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			value, exact := bn.Uint64()
+			if value != tt.expectedValue || exact != tt.expectedExact {
+				t.Errorf("BigNumber.Uint64() error: expected %d, %v but got %d, %v", tt.expectedValue, tt.expectedExact, value, exact)
+			}
+			if bn.IsUint64() != tt.expectedExact {
+				t.Errorf("BigNumber.IsUint64() error: expected %v", tt.expectedExact)
+			}
+			if roundTrip := bignumbers.FromUint64(value); tt.expectedExact && !roundTrip.Equal(bn) {
+				t.Errorf("FromUint64() error: expected %s but got %s", bn.GetHex(), roundTrip.GetHex())
+			}
+		})
+	}
+}
+
+func TestBigNumber_Float64(t *testing.T) {
+	tests := []struct {
+		name             string
+		hex              string
+		expectedFloat    float64
+		expectedAccuracy big.Accuracy
+	}{
+		{name: "Zero", hex: "", expectedFloat: 0, expectedAccuracy: big.Exact},
+		{name: "2^53", hex: "20000000000000", expectedFloat: 1 << 53, expectedAccuracy: big.Exact},
+		{name: "2^53 + 1 rounds to even", hex: "20000000000001", expectedFloat: 1 << 53, expectedAccuracy: big.Below},
+		{name: "2^53 + 3 rounds to even", hex: "20000000000003", expectedFloat: 1<<53 + 4, expectedAccuracy: big.Above},
+		{name: "Max uint64", hex: "ffffffffffffffff", expectedFloat: 1 << 64, expectedAccuracy: big.Above},
+		{name: "Half with sticky bit", hex: "200000000000010000000000000001", expectedFloat: 0x1.0000000000001p117, expectedAccuracy: big.Above},
+		{name: "Max float64", hex: "fffffffffffff8" + zeroHex(242), expectedFloat: math.MaxFloat64, expectedAccuracy: big.Exact},
+		{name: "Overflow", hex: "fffffffffffffc" + zeroHex(242), expectedFloat: math.Inf(1), expectedAccuracy: big.Above},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			f, accuracy := bn.Float64()
+			if f != tt.expectedFloat || accuracy != tt.expectedAccuracy {
+				t.Errorf("BigNumber.Float64() error: expected %v, %v but got %v, %v", tt.expectedFloat, tt.expectedAccuracy, f, accuracy)
+			}
+		})
+	}
+}
+
+func zeroHex(n int) string {
+	return new(big.Int).Lsh(big.NewInt(1), uint(4*n)).Text(16)[1:]
+}
+
+func TestFromFloat64(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       float64
+		expectedHex string
+		expectedErr error
+	}{
+		{name: "Zero", value: 0, expectedHex: ""},
+		{name: "Negative zero", value: math.Copysign(0, -1), expectedHex: ""},
+		{name: "Fraction", value: 42.9, expectedHex: "2a"},
+		{name: "2^64", value: 1 << 64, expectedHex: "10000000000000000"},
+		{name: "Large", value: 0x1.23456789abcdep100, expectedHex: "123456789abcde000000000000"},
+		{name: "Max float64", value: math.MaxFloat64, expectedHex: "fffffffffffff8" + zeroHex(242)},
+		{name: "NaN", value: math.NaN(), expectedErr: bignumbers.ErrNotFinite},
+		{name: "Inf", value: math.Inf(1), expectedErr: bignumbers.ErrNotFinite},
+		{name: "Negative", value: -1, expectedErr: bignumbers.ErrNegativeValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bn, err := bignumbers.FromFloat64(tt.value)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("FromFloat64() expected error %v but got %v", tt.expectedErr, err)
+			}
+			if hex := bn.GetHex(); hex != tt.expectedHex {
+				t.Errorf("FromFloat64() error: expected %s but got %s", tt.expectedHex, hex)
+			}
+		})
+	}
+}
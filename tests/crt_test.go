@@ -0,0 +1,150 @@
+package bignumbers_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func numbersFromHex(hexes ...string) []bignumbers.BigNumber {
+	numbers := make([]bignumbers.BigNumber, len(hexes))
+	for i, hex := range hexes {
+		numbers[i].SetHex(hex)
+	}
+	return numbers
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		name            string
+		residues        []string
+		moduli          []string
+		expectedX       string
+		expectedModulus string
+		expectedErr     error
+	}{
+		{name: "Coprime", residues: []string{"2", "3", "2"}, moduli: []string{"3", "5", "7"}, expectedX: "17", expectedModulus: "69"},
+		{name: "Not coprime", residues: []string{"3", "1"}, moduli: []string{"4", "6"}, expectedX: "7", expectedModulus: "c"},
+		{name: "Inconsistent", residues: []string{"1", "2"}, moduli: []string{"4", "6"}, expectedErr: bignumbers.ErrNoSolution},
+		{name: "Residues above moduli", residues: []string{"b", "d"}, moduli: []string{"3", "5"}, expectedX: "8", expectedModulus: "f"},
+		{name: "Repeated modulus", residues: []string{"5", "5"}, moduli: []string{"9", "9"}, expectedX: "5", expectedModulus: "9"},
+		{name: "Modulus one", residues: []string{"0", "4"}, moduli: []string{"1", "7"}, expectedX: "4", expectedModulus: "7"},
+		{name: "Empty", residues: []string{}, moduli: []string{}, expectedX: "", expectedModulus: "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, m, err := bignumbers.CRT(numbersFromHex(tt.residues...), numbersFromHex(tt.moduli...))
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("CRT() expected error %v but got %v", tt.expectedErr, err)
+			}
+			if x.GetHex() != tt.expectedX || m.GetHex() != tt.expectedModulus {
+				t.Errorf("CRT() error: expected %s mod %s but got %s mod %s", tt.expectedX, tt.expectedModulus, x.GetHex(), m.GetHex())
+			}
+		})
+	}
+}
+
+func TestCRT_InvalidInput(t *testing.T) {
+	if _, _, err := bignumbers.CRT(numbersFromHex("1"), numbersFromHex("2", "3")); err == nil {
+		t.Errorf("CRT() expected an error for mismatched lengths")
+	}
+	if _, _, err := bignumbers.CRT(numbersFromHex("1"), numbersFromHex("")); err == nil {
+		t.Errorf("CRT() expected an error for a zero modulus")
+	}
+}
+
+func TestCRT_RSA(t *testing.T) {
+	// p = 2^127 - 1 and q = 2^89 - 1 are Mersenne primes.
+	moduli := numbersFromHex("7fffffffffffffffffffffffffffffff", "1ffffffffffffffffffffff")
+	message := numbersFromHex("1234567890abcdef1234567890abcdef1234567890abcdef123456")[0]
+	residues := []bignumbers.BigNumber{message.MOD(moduli[0]), message.MOD(moduli[1])}
+	x, m, err := bignumbers.CRT(residues, moduli)
+	if err != nil {
+		t.Fatalf("CRT() error: %v", err)
+	}
+	if !x.Equal(message) || m.GetHex() != "ffffffffffffffffffffff7ffffffffe0000000000000000000001" {
+		t.Errorf("CRT() error: expected %s mod pq but got %s mod %s", message.GetHex(), x.GetHex(), m.GetHex())
+	}
+}
+
+func TestRNS(t *testing.T) {
+	basis, err := bignumbers.NewRNSBasis(numbersFromHex("1fffffffffffffff", "7fffffff", "7fffffffffffffffffffffffffffffff", "3b9aca07")...)
+	if err != nil {
+		t.Fatalf("NewRNSBasis() error: %v", err)
+	}
+	modulus := basis.Product()
+	bigModulus := bigFromHex(modulus.GetHex())
+	values := numbersFromHex(
+		"",
+		"1",
+		"1a7610b4cdd561bf481ba86d7778385752fb16a0f2d549fd341e0716946fdd1",
+		"e94206974c807ae62236c13992848936bb0b48a30a5a71486c2c6e5e85080a",
+		"1dcd6503446535f81194d7e5dcd65037c46535f97735940fdcd6503446535f8",
+	)
+	for _, a := range values {
+		for _, b := range values {
+			ra, rb := basis.FromBigNumber(a), basis.FromBigNumber(b)
+			bigA, bigB := bigFromHex(a.GetHex()), bigFromHex(b.GetHex())
+			sum, product := ra.Add(rb), ra.Mul(rb)
+			if got := sum.BigNumber(); got.GetHex() != hexOf(new(big.Int).Mod(new(big.Int).Add(bigA, bigB), bigModulus)) {
+				t.Errorf("RNS.Add() error for %s + %s: got %s", a.GetHex(), b.GetHex(), got.GetHex())
+			}
+			if got := product.BigNumber(); got.GetHex() != hexOf(new(big.Int).Mod(new(big.Int).Mul(bigA, bigB), bigModulus)) {
+				t.Errorf("RNS.Mul() error for %s * %s: got %s", a.GetHex(), b.GetHex(), got.GetHex())
+			}
+		}
+	}
+}
+
+func TestRNS_FromResidues(t *testing.T) {
+	basis, err := bignumbers.NewRNSBasis(numbersFromHex("3", "5", "7")...)
+	if err != nil {
+		t.Fatalf("NewRNSBasis() error: %v", err)
+	}
+	r, err := basis.FromResidues(numbersFromHex("2", "3", "9"))
+	if err != nil {
+		t.Fatalf("RNSBasis.FromResidues() error: %v", err)
+	}
+	if x := r.BigNumber(); x.GetHex() != "17" {
+		t.Errorf("RNS.BigNumber() error: expected 17 but got %s", x.GetHex())
+	}
+	if residues := r.Residues(); residues[2].GetHex() != "2" {
+		t.Errorf("RNS.Residues() error: expected the residues to be reduced but got %s", residues[2].GetHex())
+	}
+	if _, err := basis.FromResidues(numbersFromHex("1")); err == nil {
+		t.Errorf("RNSBasis.FromResidues() expected an error for a wrong number of residues")
+	}
+}
+
+func TestNewRNSBasis_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		moduli []string
+	}{
+		{name: "Empty", moduli: []string{}},
+		{name: "Not coprime", moduli: []string{"4", "6"}},
+		{name: "One", moduli: []string{"1", "5"}},
+		{name: "Zero", moduli: []string{"5", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := bignumbers.NewRNSBasis(numbersFromHex(tt.moduli...)...); err == nil {
+				t.Errorf("NewRNSBasis() expected an error")
+			}
+		})
+	}
+}
+
+func TestRNS_DifferentBases(t *testing.T) {
+	first, _ := bignumbers.NewRNSBasis(numbersFromHex("3", "5")...)
+	second, _ := bignumbers.NewRNSBasis(numbersFromHex("3", "5")...)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("RNS.Add() expected a panic for values over different bases")
+		}
+	}()
+	a, b := first.FromBigNumber(numbersFromHex("1")[0]), second.FromBigNumber(numbersFromHex("2")[0])
+	a.Add(b)
+}
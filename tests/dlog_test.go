@@ -0,0 +1,80 @@
+package bignumbers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestDiscreteLog(t *testing.T) {
+	tests := []struct {
+		name     string
+		g, h, p  string
+		expected string
+	}{
+		{name: "Small prime", g: "2", h: "5", p: "1019", expected: "10"},
+		{name: "One", g: "2", h: "1", p: "1019", expected: ""},
+		{name: "Unreduced arguments", g: "1021", h: "1024", p: "1019", expected: "10"},
+		{name: "Smooth order", g: "14", h: "218942797832859759772349569363470694485054", p: "228769981222494065696126560568993496045751", expected: "128671115273685262468034950745038288273405"},
+		{name: "Prime order below the BSGS limit", g: "4", h: "2918770045", p: "3244612739", expected: "1592975436"},
+		{name: "Prime order above the BSGS limit", g: "4", h: "112408195361", p: "159608304827", expected: "2601030205"},
+		{name: "Subgroup", g: "2", h: "13", p: "23", expected: "7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g, h, p bignumbers.BigNumber
+			g.SetDecimal(tt.g)
+			h.SetDecimal(tt.h)
+			p.SetDecimal(tt.p)
+			x, err := bignumbers.DiscreteLog(context.Background(), g, h, p)
+			if err != nil {
+				t.Fatalf("DiscreteLog() error: %v", err)
+			}
+			if got := x.GetDecimal(); got != tt.expected {
+				t.Errorf("DiscreteLog() error: expected %s but got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDiscreteLog_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		g, h, p    string
+		noSolution bool
+	}{
+		{name: "Outside the subgroup", g: "2", h: "5", p: "23", noSolution: true},
+		{name: "Zero argument", g: "2", h: "23", p: "23", noSolution: true},
+		{name: "Zero base", g: "46", h: "5", p: "23"},
+		{name: "Composite modulus", g: "2", h: "5", p: "1001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g, h, p bignumbers.BigNumber
+			g.SetDecimal(tt.g)
+			h.SetDecimal(tt.h)
+			p.SetDecimal(tt.p)
+			_, err := bignumbers.DiscreteLog(context.Background(), g, h, p)
+			if err == nil {
+				t.Fatalf("DiscreteLog() expected an error")
+			}
+			if got := errors.Is(err, bignumbers.ErrNoSolution); got != tt.noSolution {
+				t.Errorf("DiscreteLog() error: errors.Is(%v, ErrNoSolution) = %v", err, got)
+			}
+		})
+	}
+}
+
+func TestDiscreteLog_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var g, h, p bignumbers.BigNumber
+	g.SetDecimal("4")
+	h.SetDecimal("112408195361")
+	p.SetDecimal("159608304827")
+	if _, err := bignumbers.DiscreteLog(ctx, g, h, p); !errors.Is(err, context.Canceled) {
+		t.Errorf("DiscreteLog() expected context.Canceled but got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package bignumbers_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		name           string
+		parse          func(string) error
+		input          string
+		expectedErr    error
+		expectedOffset int
+		expectedBase   int
+	}{
+		{name: "Hex digit", parse: func(s string) error { var bn bignumbers.BigNumber; return bn.SetHex(s) }, input: "0x12g4", expectedErr: bignumbers.ErrInvalidDigit, expectedOffset: 4, expectedBase: 16},
+		{name: "Hex separator", parse: func(s string) error { var bn bignumbers.BigNumber; return bn.SetHex(s) }, input: "0x1abc__0000", expectedErr: bignumbers.ErrInvalidSeparator, expectedOffset: 7, expectedBase: 16},
+		{name: "Hex prefix inside", parse: func(s string) error { var bn bignumbers.BigNumber; return bn.SetHex(s) }, input: "ff0xff", expectedErr: bignumbers.ErrInvalidDigit, expectedOffset: 3, expectedBase: 16},
+		{name: "Binary digit", parse: func(s string) error { var bn bignumbers.BigNumber; return bn.SetBinary(s) }, input: "0b1_0_2", expectedErr: bignumbers.ErrInvalidDigit, expectedOffset: 6, expectedBase: 2},
+		{name: "Binary trailing separator", parse: func(s string) error { var bn bignumbers.BigNumber; return bn.SetBinary(s) }, input: "101_", expectedErr: bignumbers.ErrInvalidSeparator, expectedOffset: 3, expectedBase: 2},
+		{name: "Decimal digit", parse: func(s string) error { var bn bignumbers.BigNumber; return bn.SetDecimal(s) }, input: "1_000_00a", expectedErr: bignumbers.ErrInvalidDigit, expectedOffset: 8, expectedBase: 10},
+		{name: "Decimal leading separator", parse: func(s string) error { var bn bignumbers.BigNumber; return bn.SetDecimal(s) }, input: "_1", expectedErr: bignumbers.ErrInvalidSeparator, expectedOffset: 0, expectedBase: 10},
+		{name: "ValidateDecimal separator", parse: bignumbers.ValidateDecimal, input: "1_0", expectedErr: bignumbers.ErrInvalidDigit, expectedOffset: 1, expectedBase: 10},
+		{name: "Uint hex too long", parse: func(s string) error { var u bignumbers.Uint; return u.SetHex(s) }, input: "0x1_0000_0000_0000_0000", expectedErr: bignumbers.ErrTooManyDigits, expectedOffset: 22, expectedBase: 16},
+		{name: "Uint binary too long", parse: bignumbers.ValidateBinary, input: "1" + strings.Repeat("0", 64), expectedErr: bignumbers.ErrTooManyDigits, expectedOffset: 64, expectedBase: 2},
+		{name: "Unmarshal wraps", parse: func(s string) error { var bn bignumbers.BigNumber; return bn.UnmarshalText([]byte(s)) }, input: "12z", expectedErr: bignumbers.ErrInvalidDigit, expectedOffset: 2, expectedBase: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.parse(tt.input)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v but got %v", tt.expectedErr, err)
+			}
+			var parseErr *bignumbers.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected a *ParseError but got %T", err)
+			}
+			if parseErr.Input != tt.input || parseErr.Offset != tt.expectedOffset || parseErr.Base != tt.expectedBase {
+				t.Errorf("expected input %q, offset %d, base %d but got %q, %d, %d", tt.input, tt.expectedOffset, tt.expectedBase, parseErr.Input, parseErr.Offset, parseErr.Base)
+			}
+		})
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	var bn bignumbers.BigNumber
+	expected := `parsing "0x12g4": offset 4: 'g' is not a base 16 digit`
+	if err := bn.SetHex("0x12g4"); err == nil || err.Error() != expected {
+		t.Errorf("expected error %q but got %v", expected, err)
+	}
+	wrapped := &bignumbers.ParseError{Input: "0x12g4", Offset: 4, Base: 16, Err: fmt.Errorf("hex literal: %w", bignumbers.ErrInvalidDigit)}
+	if wrapped.Error() != expected {
+		t.Errorf("expected a wrapped cause to format as %q but got %q", expected, wrapped.Error())
+	}
+}
+
+func TestBigNumber_ErrNegativeResult(t *testing.T) {
+	var small, large bignumbers.BigNumber
+	small.SetHex("1")
+	large.SetHex("10000000000000000")
+	if _, err := small.SUB(large); !errors.Is(err, bignumbers.ErrNegativeResult) {
+		t.Errorf("BigNumber.SUB() expected ErrNegativeResult but got %v", err)
+	}
+	var z bignumbers.BigNumber
+	if _, err := z.Sub(&small, &large); !errors.Is(err, bignumbers.ErrNegativeResult) {
+		t.Errorf("BigNumber.Sub() expected ErrNegativeResult but got %v", err)
+	}
+}
+
+func TestBigNumber_ErrDivisionByZero(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, bignumbers.ErrDivisionByZero) {
+			t.Errorf("BigNumber.MOD() expected panic with ErrDivisionByZero but got %v", err)
+		}
+	}()
+	var bn bignumbers.BigNumber
+	bn.SetHex("ff")
+	bn.MOD(bignumbers.BigNumber{})
+}
@@ -0,0 +1,112 @@
+package bignumbers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// formatFactors renders a factorization as "p^e * q", optionally with the method of every prime.
+func formatFactors(factors []bignumbers.PrimeFactor, withMethods bool) string {
+	parts := make([]string, len(factors))
+	for i, f := range factors {
+		parts[i] = f.Prime.GetDecimal()
+		if f.Exponent > 1 {
+			parts[i] += fmt.Sprintf("^%d", f.Exponent)
+		}
+		if withMethods {
+			parts[i] += fmt.Sprintf(" (%s)", f.Method)
+		}
+	}
+	return strings.Join(parts, " * ")
+}
+
+func TestFactor(t *testing.T) {
+	tests := []struct {
+		name     string
+		decimal  string
+		expected string
+	}{
+		{name: "One", decimal: "1", expected: ""},
+		{name: "Small prime", decimal: "97", expected: "97 (trial division)"},
+		{name: "Prime powers", decimal: "1024000000000", expected: "2^19 (trial division) * 5^9 (trial division)"},
+		{name: "Trial division only", decimal: "600851475143", expected: "71 (trial division) * 839 (trial division) * 1471 (trial division) * 6857 (trial division)"},
+		{name: "Large prime cofactor", decimal: "10000000000000000000000000000001", expected: "11 (trial division) * 909090909090909090909090909091 (primality test)"},
+		{name: "Fermat F6", decimal: "18446744073709551617", expected: "274177 (Pollard rho) * 67280421310721 (Pollard rho)"},
+		{name: "Smooth p-1", decimal: "207178986304298665622493258767", expected: "207178986304291 (Pollard p-1) * 1000000000000037 (Pollard p-1)"},
+		{name: "Balanced semiprime", decimal: "12193263113807971333392601", expected: "1234567890133 (ECM) * 9876543210997 (ECM)"},
+		{name: "40-digit semiprime", decimal: "8957021371616587437343509934959791256563", expected: "90532746179102435393 (quadratic sieve) * 98936812917358800691 (quadratic sieve)"},
+		{name: "Square of a prime", decimal: "1000000014000000049", expected: "1000000007^2 (Pollard rho)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n bignumbers.BigNumber
+			n.SetDecimal(tt.decimal)
+			factors, err := bignumbers.Factor(context.Background(), n)
+			if err != nil {
+				t.Fatalf("Factor() error: %v", err)
+			}
+			if got := formatFactors(factors, true); got != tt.expected {
+				t.Errorf("Factor() error: expected %s but got %s", tt.expected, got)
+			}
+			product := bignumbers.FromUint64(1)
+			for _, f := range factors {
+				for i := 0; i < f.Exponent; i++ {
+					product = product.MUL(f.Prime)
+				}
+			}
+			if !product.Equal(n) {
+				t.Errorf("Factor() error: the factors multiply to %s", product.GetDecimal())
+			}
+		})
+	}
+}
+
+func TestFactor_Zero(t *testing.T) {
+	if _, err := bignumbers.Factor(context.Background(), bignumbers.BigNumber{}); err == nil {
+		t.Errorf("Factor() expected an error for zero")
+	}
+}
+
+func TestFactor_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var n bignumbers.BigNumber
+	// The product of two 20-digit primes, which trial division cannot split.
+	n.SetDecimal("100000000000010000780000000000051001377")
+	if _, err := bignumbers.Factor(ctx, n); !errors.Is(err, context.Canceled) {
+		t.Errorf("Factor() expected context.Canceled but got %v", err)
+	}
+}
+
+func TestBigNumber_ProbablyPrime(t *testing.T) {
+	tests := []struct {
+		name     string
+		decimal  string
+		expected bool
+	}{
+		{name: "Zero", decimal: "0", expected: false},
+		{name: "One", decimal: "1", expected: false},
+		{name: "Two", decimal: "2", expected: true},
+		{name: "Small composite", decimal: "561", expected: false},
+		{name: "Strong pseudoprime to base 2", decimal: "3215031751", expected: false},
+		{name: "Mersenne prime 2^127-1", decimal: "170141183460469231731687303715884105727", expected: true},
+		{name: "Fermat F7", decimal: "340282366920938463463374607431768211457", expected: false},
+		{name: "Carmichael number", decimal: "3825123056546413051", expected: false},
+		{name: "Strong pseudoprime to the first 13 primes", decimal: "3317044064679887385961981", expected: false},
+		{name: "Large prime", decimal: "909090909090909090909090909091", expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n bignumbers.BigNumber
+			n.SetDecimal(tt.decimal)
+			if got := n.ProbablyPrime(10); got != tt.expected {
+				t.Errorf("BigNumber.ProbablyPrime() error: expected %v for %s", tt.expected, tt.decimal)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package bignumbers_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestBigNumber_Format(t *testing.T) {
+	values := []string{"0", "1", "ff", "ffffffffffffffff", "10000000000000000", "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4"}
+	formats := []string{"%d", "%v", "%s", "%x", "%X", "%b", "%o", "%O", "%#x", "%#X", "%#b", "%#o", "%+d", "% d", "%30d", "%-30x|", "%030x", "%#030x", "%.5d", "%.0d", "%10.4x", "%+#x", "%q"}
+	for _, hex := range values {
+		var bn bignumbers.BigNumber
+		bn.SetHex(hex)
+		expected, _ := new(big.Int).SetString(hex, 16)
+		for _, format := range formats {
+			t.Run(hex+" "+format, func(t *testing.T) {
+				want := fmt.Sprintf(format, expected)
+				got := fmt.Sprintf(format, bn)
+				if format == "%q" {
+					want = fmt.Sprintf("%%!q(bignumbers.BigNumber=%s)", expected.String())
+				}
+				if got != want {
+					t.Errorf("BigNumber.Format(%q) error: expected %q but got %q", format, want, got)
+				}
+			})
+		}
+	}
+}
+
+func TestBigNumber_String(t *testing.T) {
+	var bn bignumbers.BigNumber
+	bn.SetHex("10000000000000000")
+	if s := bn.String(); s != "18446744073709551616" {
+		t.Errorf("BigNumber.String() error: expected 18446744073709551616 but got %s", s)
+	}
+	if s := fmt.Sprint(&bn); s != "18446744073709551616" {
+		t.Errorf("fmt.Sprint(*BigNumber) error: expected 18446744073709551616 but got %s", s)
+	}
+}
+
+func TestBigNumber_Scanner(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		format      string
+		expectedHex string
+		wantErr     bool
+	}{
+		{name: "Decimal", input: "18446744073709551616", format: "%d", expectedHex: "10000000000000000"},
+		{name: "Signed decimal", input: "+255", format: "%d", expectedHex: "ff"},
+		{name: "Hex", input: "51bf608414ad5726a3c1bec098f77b1b", format: "%x", expectedHex: "51bf608414ad5726a3c1bec098f77b1b"},
+		{name: "Prefixed hex", input: "0XABCDEF", format: "%X", expectedHex: "abcdef"},
+		{name: "Binary", input: "1010", format: "%b", expectedHex: "a"},
+		{name: "Octal", input: "777", format: "%o", expectedHex: "1ff"},
+		{name: "Auto hex", input: "0xff", format: "%v", expectedHex: "ff"},
+		{name: "Auto binary", input: "0b1111", format: "%v", expectedHex: "f"},
+		{name: "Auto octal", input: "0o17", format: "%v", expectedHex: "f"},
+		{name: "Auto legacy octal", input: "017", format: "%v", expectedHex: "f"},
+		{name: "Auto decimal", input: "4096", format: "%v", expectedHex: "1000"},
+		{name: "Zero", input: "0", format: "%v", expectedHex: ""},
+		{name: "Invalid decimal", input: "12ab", format: "%d", wantErr: true},
+		{name: "Invalid octal", input: "8", format: "%o", wantErr: true},
+		{name: "Negative", input: "-5", format: "%d", wantErr: true},
+		{name: "Bad verb", input: "5", format: "%e", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			_, err := fmt.Sscanf(tt.input, tt.format, &bn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BigNumber.Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && bn.GetHex() != tt.expectedHex {
+				t.Errorf("BigNumber.Scan() error: expected %s but got %s", tt.expectedHex, bn.GetHex())
+			}
+		})
+	}
+
+	var a, b bignumbers.BigNumber
+	if _, err := fmt.Sscan("0x10 42", &a, &b); err != nil {
+		t.Fatalf("fmt.Sscan() error: %v", err)
+	}
+	if a.GetHex() != "10" || b.GetHex() != "2a" {
+		t.Errorf("fmt.Sscan() error: expected 10 and 2a but got %s and %s", a.GetHex(), b.GetHex())
+	}
+}
@@ -0,0 +1,344 @@
+package bignumbers_test
+
+import (
+	"encoding/hex"
+	"math"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// maxFuzzBytes bounds the size of fuzzed operands.
+const maxFuzzBytes = 256
+
+// fuzzOperand builds a BigNumber from big-endian bytes through SetHex, together with the equivalent big.Int.
+func fuzzOperand(t *testing.T, data []byte) (bignumbers.BigNumber, *big.Int) {
+	t.Helper()
+	if len(data) > maxFuzzBytes {
+		data = data[:maxFuzzBytes]
+	}
+	var bn bignumbers.BigNumber
+	if err := bn.SetHex(hex.EncodeToString(data)); err != nil {
+		t.Fatalf("BigNumber.SetHex(%x) error: %v", data, err)
+	}
+	return bn, new(big.Int).SetBytes(data)
+}
+
+func checkEqual(t *testing.T, operation string, got bignumbers.BigNumber, expected *big.Int) {
+	t.Helper()
+	if got.GetHex() != hexOf(expected) {
+		t.Fatalf("%s error: expected %s but got %s", operation, hexOf(expected), got.GetHex())
+	}
+}
+
+func addFuzzSeeds(f *testing.F) {
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte{0x01}, []byte{0x00})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, []byte{0x01})
+	f.Add([]byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, []byte{0x01})
+	f.Add([]byte{0x00, 0x00, 0x12, 0x34}, []byte{0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56})
+}
+
+func FuzzBigNumber_ADD(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, left, right []byte) {
+		a, bigA := fuzzOperand(t, left)
+		b, bigB := fuzzOperand(t, right)
+		sum := a.ADD(b)
+		checkEqual(t, "BigNumber.ADD()", sum, new(big.Int).Add(bigA, bigB))
+		if reversed := b.ADD(a); reversed.GetHex() != sum.GetHex() {
+			t.Fatalf("BigNumber.ADD() is not commutative: %s + %s = %s but %s + %s = %s", a.GetHex(), b.GetHex(), sum.GetHex(), b.GetHex(), a.GetHex(), reversed.GetHex())
+		}
+		diff, err := sum.SUB(b)
+		if err != nil {
+			t.Fatalf("BigNumber.SUB() error: %v", err)
+		}
+		if diff.GetHex() != a.GetHex() {
+			t.Fatalf("(a+b)-b != a: expected %s but got %s", a.GetHex(), diff.GetHex())
+		}
+	})
+}
+
+func FuzzBigNumber_SUB(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, left, right []byte) {
+		a, bigA := fuzzOperand(t, left)
+		b, bigB := fuzzOperand(t, right)
+		diff, err := a.SUB(b)
+		if bigA.Cmp(bigB) < 0 {
+			if err == nil {
+				t.Fatalf("BigNumber.SUB() expected an error for %s - %s", a.GetHex(), b.GetHex())
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("BigNumber.SUB() error: %v", err)
+		}
+		checkEqual(t, "BigNumber.SUB()", diff, new(big.Int).Sub(bigA, bigB))
+		if sum := diff.ADD(b); sum.GetHex() != a.GetHex() {
+			t.Fatalf("(a-b)+b != a: expected %s but got %s", a.GetHex(), sum.GetHex())
+		}
+	})
+}
+
+func FuzzBigNumber_Bitwise(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, left, right []byte) {
+		a, bigA := fuzzOperand(t, left)
+		b, bigB := fuzzOperand(t, right)
+
+		xor, and, or := a.XOR(b), a.AND(b), a.OR(b)
+		checkEqual(t, "BigNumber.XOR()", xor, new(big.Int).Xor(bigA, bigB))
+		checkEqual(t, "BigNumber.AND()", and, new(big.Int).And(bigA, bigB))
+		checkEqual(t, "BigNumber.OR()", or, new(big.Int).Or(bigA, bigB))
+
+		if reversed := b.XOR(a); reversed.GetHex() != xor.GetHex() {
+			t.Fatalf("BigNumber.XOR() is not commutative")
+		}
+		if reversed := b.AND(a); reversed.GetHex() != and.GetHex() {
+			t.Fatalf("BigNumber.AND() is not commutative")
+		}
+		if reversed := b.OR(a); reversed.GetHex() != or.GetHex() {
+			t.Fatalf("BigNumber.OR() is not commutative")
+		}
+		if back := xor.XOR(b); back.GetHex() != a.GetHex() {
+			t.Fatalf("(a^b)^b != a: expected %s but got %s", a.GetHex(), back.GetHex())
+		}
+		if union := and.OR(xor); union.GetHex() != or.GetHex() {
+			t.Fatalf("(a&b)|(a^b) != a|b: expected %s but got %s", or.GetHex(), union.GetHex())
+		}
+	})
+}
+
+func FuzzBigNumber_Shift(f *testing.F) {
+	f.Add([]byte{}, uint16(0))
+	f.Add([]byte{0x01}, uint16(64))
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, uint16(3))
+	f.Add([]byte{0x51, 0xbf, 0x60, 0x84, 0x14, 0xad, 0x57, 0x26, 0xa3, 0xc1, 0xbe, 0xc0}, uint16(127))
+	f.Fuzz(func(t *testing.T, data []byte, shift uint16) {
+		a, bigA := fuzzOperand(t, data)
+		n := int(shift % 1024)
+		left := a.ShiftL(n)
+		checkEqual(t, "BigNumber.ShiftL()", left, new(big.Int).Lsh(bigA, uint(n)))
+		checkEqual(t, "BigNumber.ShiftR()", a.ShiftR(n), new(big.Int).Rsh(bigA, uint(n)))
+		if back := left.ShiftR(n); back.GetHex() != a.GetHex() {
+			t.Fatalf("(a<<n)>>n != a: expected %s but got %s", a.GetHex(), back.GetHex())
+		}
+	})
+}
+
+func FuzzBigNumber_MOD(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Add([]byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	f.Fuzz(func(t *testing.T, left, right []byte) {
+		a, bigA := fuzzOperand(t, left)
+		b, bigB := fuzzOperand(t, right)
+		if bigB.Sign() == 0 {
+			return
+		}
+		mod := a.MOD(b)
+		checkEqual(t, "BigNumber.MOD()", mod, new(big.Int).Mod(bigA, bigB))
+		if !mod.LessThan(b) {
+			t.Fatalf("a mod b >= b: %s mod %s = %s", a.GetHex(), b.GetHex(), mod.GetHex())
+		}
+		quotient, remainder := a.DivMod(b)
+		checkEqual(t, "BigNumber.DivMod()", quotient, new(big.Int).Div(bigA, bigB))
+		if !remainder.Equal(mod) {
+			t.Fatalf("BigNumber.DivMod() remainder %s differs from MOD %s", remainder.GetHex(), mod.GetHex())
+		}
+	})
+}
+
+func FuzzBigNumber_MUL(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, left, right []byte) {
+		a, bigA := fuzzOperand(t, left)
+		b, bigB := fuzzOperand(t, right)
+		product := a.MUL(b)
+		checkEqual(t, "BigNumber.MUL()", product, new(big.Int).Mul(bigA, bigB))
+		if bigB.Sign() == 0 {
+			return
+		}
+		if quotient := product.DIV(b); !quotient.Equal(a) {
+			t.Fatalf("(a*b)/b != a: expected %s but got %s", a.GetHex(), quotient.GetHex())
+		}
+	})
+}
+
+func FuzzBigNumber_GCD(f *testing.F) {
+	addFuzzSeeds(f)
+	f.Add([]byte{0x0c}, []byte{0x12})
+	f.Fuzz(func(t *testing.T, left, right []byte) {
+		a, bigA := fuzzOperand(t, left)
+		b, bigB := fuzzOperand(t, right)
+		checkEqual(t, "BigNumber.GCD()", a.GCD(b), new(big.Int).GCD(nil, nil, bigA, bigB))
+		if bigB.Sign() == 0 {
+			return
+		}
+		inverse, err := a.ModInverse(b)
+		expected := new(big.Int).ModInverse(bigA, bigB)
+		if bigB.Cmp(big.NewInt(1)) == 0 {
+			expected = new(big.Int)
+		}
+		if expected == nil {
+			if err == nil {
+				t.Fatalf("BigNumber.ModInverse() expected an error for %s mod %s", a.GetHex(), b.GetHex())
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("BigNumber.ModInverse() error: %v", err)
+		}
+		checkEqual(t, "BigNumber.ModInverse()", inverse, expected)
+	})
+}
+
+// quickNumber is a random BigNumber generator for testing/quick.
+type quickNumber struct {
+	value bignumbers.BigNumber
+}
+
+func (quickNumber) Generate(rand *rand.Rand, size int) reflect.Value {
+	blocks := make([]bignumbers.Uint, rand.Intn(6))
+	for i := range blocks {
+		switch rand.Intn(4) {
+		case 0:
+			blocks[i] = bignumbers.Uint{Value: 0}
+		case 1:
+			blocks[i] = bignumbers.Uint{Value: ^uint64(0)}
+		default:
+			blocks[i] = bignumbers.Uint{Value: rand.Uint64()}
+		}
+	}
+	for len(blocks) > 0 && blocks[len(blocks)-1].GetDecimal() == 0 {
+		blocks = blocks[:len(blocks)-1]
+	}
+	var bn bignumbers.BigNumber
+	bn.SetBlocks(blocks)
+	return reflect.ValueOf(quickNumber{value: bn})
+}
+
+func TestBigNumber_Properties(t *testing.T) {
+	config := &quick.Config{MaxCount: 300, Rand: rand.New(rand.NewSource(1))}
+	properties := map[string]any{
+		"ADD is associative": func(a, b, c quickNumber) bool {
+			ab := a.value.ADD(b.value)
+			bc := b.value.ADD(c.value)
+			left, right := ab.ADD(c.value), a.value.ADD(bc)
+			return left.GetHex() == right.GetHex()
+		},
+		"zero is the identity of ADD and XOR": func(a quickNumber) bool {
+			sum, xor := a.value.ADD(bignumbers.BigNumber{}), a.value.XOR(bignumbers.BigNumber{})
+			return sum.GetHex() == a.value.GetHex() && xor.GetHex() == a.value.GetHex()
+		},
+		"a - a is zero": func(a quickNumber) bool {
+			diff, err := a.value.SUB(a.value)
+			return err == nil && diff.GetHex() == ""
+		},
+		"AND distributes over OR": func(a, b, c quickNumber) bool {
+			bc := b.value.OR(c.value)
+			ab, ac := a.value.AND(b.value), a.value.AND(c.value)
+			left, right := a.value.AND(bc), ab.OR(ac)
+			return left.GetHex() == right.GetHex()
+		},
+		"shifts compose": func(a quickNumber, m, n uint8) bool {
+			once := a.value.ShiftL(int(m) + int(n))
+			first := a.value.ShiftL(int(m))
+			twice := first.ShiftL(int(n))
+			return once.GetHex() == twice.GetHex()
+		},
+		"MUL distributes over ADD": func(a, b, c quickNumber) bool {
+			bc := b.value.ADD(c.value)
+			ab, ac := a.value.MUL(b.value), a.value.MUL(c.value)
+			left, right := a.value.MUL(bc), ab.ADD(ac)
+			return left.GetHex() == right.GetHex()
+		},
+		"a = (a div b)*b + a mod b": func(a, b quickNumber) bool {
+			if b.value.IsZero() {
+				return true
+			}
+			quotient, remainder := a.value.DivMod(b.value)
+			product := quotient.MUL(b.value)
+			sum := product.ADD(remainder)
+			return sum.Equal(a.value) && remainder.LessThan(b.value)
+		},
+		"a + b matches math/big": func(a, b quickNumber) bool {
+			sum := a.value.ADD(b.value)
+			return sum.GetHex() == hexOf(new(big.Int).Add(bigFromHex(a.value.GetHex()), bigFromHex(b.value.GetHex())))
+		},
+	}
+	for name, property := range properties {
+		t.Run(name, func(t *testing.T) {
+			if err := quick.Check(property, config); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func FuzzBigNumber_Scalar(f *testing.F) {
+	f.Add([]byte{}, uint64(0))
+	f.Add([]byte{0x01}, uint64(1))
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, uint64(1))
+	f.Add([]byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, ^uint64(0))
+	f.Add([]byte{0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56}, uint64(10000000000000000000))
+	f.Fuzz(func(t *testing.T, data []byte, x uint64) {
+		a, bigA := fuzzOperand(t, data)
+		bigX := new(big.Int).SetUint64(x)
+		sum := a.AddUint64(x)
+		checkEqual(t, "BigNumber.AddUint64()", sum, new(big.Int).Add(bigA, bigX))
+		product := a.MulUint64(x)
+		checkEqual(t, "BigNumber.MulUint64()", product, new(big.Int).Mul(bigA, bigX))
+		if cmp := a.CmpUint64(x); cmp != bigA.Cmp(bigX) {
+			t.Fatalf("BigNumber.CmpUint64() error: expected %d but got %d", bigA.Cmp(bigX), cmp)
+		}
+		diff, err := a.SubUint64(x)
+		if bigA.Cmp(bigX) < 0 {
+			if err == nil {
+				t.Fatalf("BigNumber.SubUint64() expected an error for %s - %d", a.GetHex(), x)
+			}
+		} else {
+			if err != nil {
+				t.Fatalf("BigNumber.SubUint64() error: %v", err)
+			}
+			checkEqual(t, "BigNumber.SubUint64()", diff, new(big.Int).Sub(bigA, bigX))
+		}
+		if x == 0 {
+			return
+		}
+		quotient, remainder := a.DivModUint64(x)
+		bigQ, bigR := new(big.Int).QuoRem(bigA, bigX, new(big.Int))
+		checkEqual(t, "BigNumber.DivModUint64()", quotient, bigQ)
+		if remainder != bigR.Uint64() {
+			t.Fatalf("BigNumber.DivModUint64() error: expected remainder %d but got %d", bigR.Uint64(), remainder)
+		}
+	})
+}
+
+func FuzzBigNumber_Float64(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	f.Add([]byte{0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		a, bigA := fuzzOperand(t, data)
+		expected, expectedAccuracy := new(big.Float).SetInt(bigA).Float64()
+		got, accuracy := a.Float64()
+		if got != expected || accuracy != expectedAccuracy {
+			t.Fatalf("BigNumber.Float64() error: expected %v, %v but got %v, %v", expected, expectedAccuracy, got, accuracy)
+		}
+		if math.IsInf(got, 0) {
+			return
+		}
+		roundTrip, err := bignumbers.FromFloat64(got)
+		if err != nil {
+			t.Fatalf("FromFloat64(%v) error: %v", got, err)
+		}
+		bigRoundTrip, _ := new(big.Float).SetFloat64(got).Int(nil)
+		checkEqual(t, "FromFloat64()", roundTrip, bigRoundTrip)
+	})
+}
@@ -0,0 +1,178 @@
+package bignumbers_test
+
+import (
+	"math/big"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func newFromHex(hex string) *bignumbers.BigNumber {
+	bn := new(bignumbers.BigNumber)
+	bn.SetHex(hex)
+	return bn
+}
+
+func TestBigNumber_InPlace(t *testing.T) {
+	type operation struct {
+		name     string
+		apply    func(z, x, y *bignumbers.BigNumber) *bignumbers.BigNumber
+		expected func(x, y *big.Int) *big.Int
+	}
+	operations := []operation{
+		{name: "Add", apply: func(z, x, y *bignumbers.BigNumber) *bignumbers.BigNumber { return z.Add(x, y) }, expected: func(x, y *big.Int) *big.Int { return new(big.Int).Add(x, y) }},
+		{name: "Xor", apply: func(z, x, y *bignumbers.BigNumber) *bignumbers.BigNumber { return z.Xor(x, y) }, expected: func(x, y *big.Int) *big.Int { return new(big.Int).Xor(x, y) }},
+		{name: "And", apply: func(z, x, y *bignumbers.BigNumber) *bignumbers.BigNumber { return z.And(x, y) }, expected: func(x, y *big.Int) *big.Int { return new(big.Int).And(x, y) }},
+		{name: "Or", apply: func(z, x, y *bignumbers.BigNumber) *bignumbers.BigNumber { return z.Or(x, y) }, expected: func(x, y *big.Int) *big.Int { return new(big.Int).Or(x, y) }},
+	}
+	for _, op := range operations {
+		for _, left := range bitTestValues {
+			for _, right := range bitTestValues {
+				expected := hexOf(op.expected(bigFromHex(left), bigFromHex(right)))
+
+				z := newFromHex("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+				if result := op.apply(z, newFromHex(left), newFromHex(right)); result != z || z.GetHex() != expected {
+					t.Errorf("BigNumber.%s(%s, %s) error: expected %s but got %s", op.name, left, right, expected, z.GetHex())
+				}
+
+				x, y := newFromHex(left), newFromHex(right)
+				if op.apply(x, x, y); x.GetHex() != expected {
+					t.Errorf("BigNumber.%s(%s, %s) aliasing x error: expected %s but got %s", op.name, left, right, expected, x.GetHex())
+				}
+				x, y = newFromHex(left), newFromHex(right)
+				if op.apply(y, x, y); y.GetHex() != expected {
+					t.Errorf("BigNumber.%s(%s, %s) aliasing y error: expected %s but got %s", op.name, left, right, expected, y.GetHex())
+				}
+			}
+		}
+	}
+}
+
+func TestBigNumber_InPlaceSub(t *testing.T) {
+	for _, left := range bitTestValues {
+		for _, right := range bitTestValues {
+			x, y := newFromHex(left), newFromHex(right)
+			z := newFromHex("abc")
+			_, err := z.Sub(x, y)
+			if bigFromHex(left).Cmp(bigFromHex(right)) < 0 {
+				if err == nil || z.GetHex() != "abc" {
+					t.Errorf("BigNumber.Sub(%s, %s) error: expected an error and an unchanged receiver but got %v and %s", left, right, err, z.GetHex())
+				}
+				continue
+			}
+			expected := hexOf(new(big.Int).Sub(bigFromHex(left), bigFromHex(right)))
+			if err != nil || z.GetHex() != expected {
+				t.Errorf("BigNumber.Sub(%s, %s) error: expected %s but got %s (%v)", left, right, expected, z.GetHex(), err)
+			}
+			if x.Sub(x, y); x.GetHex() != expected {
+				t.Errorf("BigNumber.Sub(%s, %s) aliasing error: expected %s but got %s", left, right, expected, x.GetHex())
+			}
+		}
+	}
+}
+
+func TestBigNumber_InPlaceShift(t *testing.T) {
+	shifts := []uint{0, 1, 3, 63, 64, 65, 128, 200}
+	for _, hex := range bitTestValues {
+		for _, n := range shifts {
+			expectedLeft := hexOf(new(big.Int).Lsh(bigFromHex(hex), n))
+			expectedRight := hexOf(new(big.Int).Rsh(bigFromHex(hex), n))
+
+			var z bignumbers.BigNumber
+			if z.Lsh(newFromHex(hex), n); z.GetHex() != expectedLeft {
+				t.Errorf("BigNumber.Lsh(%s, %d) error: expected %s but got %s", hex, n, expectedLeft, z.GetHex())
+			}
+			if z.Rsh(newFromHex(hex), n); z.GetHex() != expectedRight {
+				t.Errorf("BigNumber.Rsh(%s, %d) error: expected %s but got %s", hex, n, expectedRight, z.GetHex())
+			}
+
+			x := newFromHex(hex)
+			if x.Lsh(x, n); x.GetHex() != expectedLeft {
+				t.Errorf("BigNumber.Lsh(%s, %d) aliasing error: expected %s but got %s", hex, n, expectedLeft, x.GetHex())
+			}
+			x = newFromHex(hex)
+			if x.Rsh(x, n); x.GetHex() != expectedRight {
+				t.Errorf("BigNumber.Rsh(%s, %d) aliasing error: expected %s but got %s", hex, n, expectedRight, x.GetHex())
+			}
+		}
+	}
+}
+
+func TestBigNumber_Set(t *testing.T) {
+	x := newFromHex("51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4")
+	var z bignumbers.BigNumber
+	z.Set(x)
+	z.Add(&z, newFromHex("1"))
+	if x.GetHex() != "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4" {
+		t.Errorf("BigNumber.Set() error: updating the copy modified the original to %s", x.GetHex())
+	}
+	if z.GetHex() != "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea5" {
+		t.Errorf("BigNumber.Set() error: expected the incremented copy but got %s", z.GetHex())
+	}
+}
+
+func TestBigNumber_InPlaceAllocs(t *testing.T) {
+	x := newFromHex("51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4")
+	y := newFromHex("403db8ad88a3932a0b7e8189aed9eeffb8121dfac05c3512fdb396dd73f6331c")
+	var z bignumbers.BigNumber
+	z.Add(x, y)
+	z.Lsh(&z, 256)
+	operations := map[string]func(){
+		"Add": func() { z.Add(x, y) },
+		"Sub": func() { z.Sub(x, y) },
+		"Xor": func() { z.Xor(x, y) },
+		"And": func() { z.And(x, y) },
+		"Or":  func() { z.Or(x, y) },
+		"Lsh": func() { z.Lsh(x, 67) },
+		"Rsh": func() { z.Rsh(x, 67) },
+		"Set": func() { z.Set(x) },
+		"Accumulate": func() {
+			z.Set(x)
+			for i := 0; i < 16; i++ {
+				z.Xor(&z, y)
+				z.Add(&z, y)
+			}
+		},
+	}
+	for name, operation := range operations {
+		if allocs := testing.AllocsPerRun(100, operation); allocs != 0 {
+			t.Errorf("BigNumber.%s allocated %.1f times per run, expected 0", name, allocs)
+		}
+	}
+}
+
+func BenchmarkBigNumber_Add(b *testing.B) {
+	x := newFromHex("51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4")
+	y := newFromHex("403db8ad88a3932a0b7e8189aed9eeffb8121dfac05c3512fdb396dd73f6331c")
+	b.Run("ADD", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			x.ADD(*y)
+		}
+	})
+	b.Run("InPlace", func(b *testing.B) {
+		var z bignumbers.BigNumber
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			z.Add(x, y)
+		}
+	})
+}
+
+func BenchmarkBigNumber_Xor(b *testing.B) {
+	x := newFromHex("51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4")
+	y := newFromHex("403db8ad88a3932a0b7e8189aed9eeffb8121dfac05c3512fdb396dd73f6331c")
+	b.Run("XOR", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			x.XOR(*y)
+		}
+	})
+	b.Run("InPlace", func(b *testing.B) {
+		var z bignumbers.BigNumber
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			z.Xor(x, y)
+		}
+	})
+}
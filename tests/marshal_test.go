@@ -0,0 +1,177 @@
+package bignumbers_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestBigNumber_Decimal(t *testing.T) {
+	tests := []struct {
+		name            string
+		hex             string
+		expectedDecimal string
+	}{
+		{name: "Decimal #1", hex: "ff", expectedDecimal: "255"},
+		{name: "Decimal #2", hex: "FFFFFFFFFFFFFFFF", expectedDecimal: "18446744073709551615"},
+		{name: "Decimal #3", hex: "10000000000000000", expectedDecimal: "18446744073709551616"},
+		{name: "Decimal #4", hex: "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4", expectedDecimal: "36975474653157054774828021269746402683982340877533073654506438589893109550756"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			if decimal := bn.GetDecimal(); decimal != tt.expectedDecimal {
+				t.Errorf("BigNumber.GetDecimal() error: expected %s but got %s", tt.expectedDecimal, decimal)
+			}
+			var parsed bignumbers.BigNumber
+			if err := parsed.SetDecimal(tt.expectedDecimal); err != nil {
+				t.Fatalf("BigNumber.SetDecimal() error: %v", err)
+			}
+			if hex := parsed.GetHex(); hex != strings.ToLower(tt.hex) {
+				t.Errorf("BigNumber.SetDecimal() error: expected %s but got %s", tt.hex, hex)
+			}
+		})
+	}
+}
+
+func TestBigNumber_MarshalText(t *testing.T) {
+	tests := []struct {
+		name         string
+		text         string
+		expectedText string
+		wantErr      bool
+	}{
+		{name: "Hex", text: "0x51bf608414ad5726a3c1bec098f77b1b", expectedText: "0x51bf608414ad5726a3c1bec098f77b1b"},
+		{name: "Upper hex prefix", text: "0XFF", expectedText: "0xff"},
+		{name: "Binary", text: "0b1010", expectedText: "0xa"},
+		{name: "Decimal", text: "18446744073709551616", expectedText: "0x10000000000000000"},
+		{name: "Zero", text: "0", expectedText: "0x0"},
+		{name: "Leading zeros", text: "0x00000000000000000000000000001", expectedText: "0x1"},
+		{name: "Empty", text: "", wantErr: true},
+		{name: "Invalid digit", text: "0x12g4", wantErr: true},
+		{name: "Negative", text: "-5", wantErr: true},
+		{name: "Hex prefix only", text: "0x", wantErr: true},
+		{name: "Upper binary prefix only", text: "0B", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			err := bn.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BigNumber.UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			text, _ := bn.MarshalText()
+			if string(text) != tt.expectedText {
+				t.Errorf("BigNumber.MarshalText() error: expected %s but got %s", tt.expectedText, text)
+			}
+		})
+	}
+}
+
+func TestBigNumber_UnmarshalText_PrefixOnly(t *testing.T) {
+	for _, text := range []string{"0x", "0X", "0b", "0B"} {
+		var bn bignumbers.BigNumber
+		err := bn.UnmarshalText([]byte(text))
+		var parseErr *bignumbers.ParseError
+		if !errors.As(err, &parseErr) || !errors.Is(err, bignumbers.ErrInvalidDigit) || parseErr.Offset != 2 {
+			t.Errorf("BigNumber.UnmarshalText(%q) expected a ParseError at offset 2 wrapping ErrInvalidDigit but got %v", text, err)
+		}
+	}
+}
+
+func TestBigNumber_MarshalJSON(t *testing.T) {
+	type payload struct {
+		Value bignumbers.BigNumber  `json:"value"`
+		Ptr   *bignumbers.BigNumber `json:"ptr,omitempty"`
+	}
+	tests := []struct {
+		name        string
+		json        string
+		expectedHex string
+		wantErr     bool
+	}{
+		{name: "Quoted hex", json: `{"value":"0xabcdef0123456789fedcba9876543210"}`, expectedHex: "abcdef0123456789fedcba9876543210"},
+		{name: "Quoted decimal", json: `{"value":"255"}`, expectedHex: "ff"},
+		{name: "Bare number", json: `{"value":36975474653157054774828021269746402683982340877533073654506438589893109550756}`, expectedHex: "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4"},
+		{name: "Null", json: `{"value":null}`, expectedHex: ""},
+		{name: "Fraction", json: `{"value":1.5}`, wantErr: true},
+		{name: "Exponent", json: `{"value":1e10}`, wantErr: true},
+		{name: "Boolean", json: `{"value":true}`, wantErr: true},
+		{name: "Quoted hex prefix only", json: `{"value":"0x"}`, wantErr: true},
+		{name: "Quoted binary prefix only", json: `{"value":"0b"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p payload
+			err := json.Unmarshal([]byte(tt.json), &p)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if hex := p.Value.GetHex(); hex != tt.expectedHex {
+				t.Errorf("BigNumber.UnmarshalJSON() error: expected %s but got %s", tt.expectedHex, hex)
+			}
+			encoded, err := json.Marshal(p)
+			if err != nil {
+				t.Fatalf("json.Marshal() error: %v", err)
+			}
+			var decoded payload
+			if err := json.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("json.Unmarshal() round trip error: %v", err)
+			}
+			if !testEquality(decoded.Value.GetBlocks(), p.Value.GetBlocks()) {
+				t.Errorf("JSON round trip error: expected %v but got %v", p.Value.GetBlocks(), decoded.Value.GetBlocks())
+			}
+		})
+	}
+}
+
+func TestBigNumber_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name           string
+		hex            string
+		expectedLength int
+	}{
+		{name: "Zero", hex: "0", expectedLength: 1},
+		{name: "One byte", hex: "7f", expectedLength: 2},
+		{name: "Partial block", hex: "10000000000000000", expectedLength: 10},
+		{name: "Four blocks", hex: "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4", expectedLength: 33},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			data, err := bn.MarshalBinary()
+			if err != nil {
+				t.Fatalf("BigNumber.MarshalBinary() error: %v", err)
+			}
+			if len(data) != tt.expectedLength {
+				t.Errorf("BigNumber.MarshalBinary() error: expected %d bytes but got %d", tt.expectedLength, len(data))
+			}
+			var decoded bignumbers.BigNumber
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatalf("BigNumber.UnmarshalBinary() error: %v", err)
+			}
+			if decoded.GetHex() != bn.GetHex() {
+				t.Errorf("BigNumber.UnmarshalBinary() error: expected %s but got %s", bn.GetHex(), decoded.GetHex())
+			}
+		})
+	}
+
+	var bn bignumbers.BigNumber
+	if err := bn.UnmarshalBinary([]byte{3, 1, 2}); err == nil {
+		t.Errorf("BigNumber.UnmarshalBinary() expected an error for a truncated payload")
+	}
+	if err := bn.UnmarshalBinary(nil); err == nil {
+		t.Errorf("BigNumber.UnmarshalBinary() expected an error for an empty payload")
+	}
+}
@@ -0,0 +1,158 @@
+package bignumbers_test
+
+import (
+	"errors"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestModSqrt(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, p     string
+		expected string
+	}{
+		{name: "p = 3 mod 4", a: "2", p: "7", expected: "3"},
+		{name: "Tonelli-Shanks", a: "10", p: "13", expected: "6"},
+		{name: "Zero", a: "41", p: "41", expected: ""},
+		{name: "Modulo 2", a: "5", p: "2", expected: "1"},
+		{name: "Large Tonelli-Shanks", a: "286703323438904840909038692556", p: "1000000000000000000000000000529", expected: "139062271786581686961020907623"},
+		{name: "Cipolla", a: "209050422021981058700700623805780", p: "209162349037657851246956028887041", expected: "86705186609123037022012716852846"},
+		{
+			name:     "secp256k1 point decompression",
+			a:        "32748224938747404814623910738487752935528512903530129802856995983256684603122",
+			p:        "115792089237316195423570985008687907853269984665640564039457584007908834671663",
+			expected: "32670510020758816978083085130507043184471273380659243275938904335757337482424",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := bignumbers.ModSqrt(fromDecimal(tt.a), fromDecimal(tt.p))
+			if err != nil {
+				t.Fatalf("ModSqrt() error: %v", err)
+			}
+			if got := r.GetDecimal(); got != tt.expected {
+				t.Errorf("ModSqrt() error: expected %s but got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestModSqrt_Errors(t *testing.T) {
+	if _, err := bignumbers.ModSqrt(fromDecimal("3"), fromDecimal("7")); !errors.Is(err, bignumbers.ErrNoSolution) {
+		t.Errorf("ModSqrt() expected ErrNoSolution for a non-residue but got %v", err)
+	}
+	if _, err := bignumbers.ModSqrt(fromDecimal("4"), fromDecimal("15")); !errors.Is(err, bignumbers.ErrDomain) {
+		t.Errorf("ModSqrt() expected ErrDomain for a composite modulus but got %v", err)
+	}
+}
+
+func TestModSqrtPrimePower(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, p  string
+		k     int
+		noErr bool
+	}{
+		{name: "Odd prime", a: "439623224164660324", p: "1000003", k: 3, noErr: true},
+		{name: "Quadratic lift", a: "2", p: "7", k: 20, noErr: true},
+		{name: "Divisible by p^2", a: "36", p: "3", k: 5, noErr: true},
+		{name: "Non-residue times p^2", a: "18", p: "3", k: 5},
+		{name: "Zero", a: "243", p: "3", k: 5, noErr: true},
+		{name: "Power of two", a: "17", p: "2", k: 70, noErr: true},
+		{name: "Modulo 4", a: "5", p: "2", k: 2, noErr: true},
+		{name: "Even square", a: "68", p: "2", k: 10, noErr: true},
+		{name: "Odd valuation", a: "6", p: "3", k: 4},
+		{name: "Not 1 mod 8", a: "5", p: "2", k: 3},
+		{name: "Non-residue", a: "3", p: "7", k: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, p := fromDecimal(tt.a), fromDecimal(tt.p)
+			r, err := bignumbers.ModSqrtPrimePower(a, p, tt.k)
+			if !tt.noErr {
+				if !errors.Is(err, bignumbers.ErrNoSolution) {
+					t.Errorf("ModSqrtPrimePower() expected ErrNoSolution but got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ModSqrtPrimePower() error: %v", err)
+			}
+			modulus := bignumbers.FromUint64(1)
+			for i := 0; i < tt.k; i++ {
+				modulus = modulus.MUL(p)
+			}
+			square := r.MUL(r)
+			square = square.MOD(modulus)
+			if expected := a.MOD(modulus); !square.Equal(expected) || !r.LessThan(modulus) {
+				t.Errorf("ModSqrtPrimePower() error: %s^2 mod %s is %s, expected %s", r.GetDecimal(), modulus.GetDecimal(), square.GetDecimal(), expected.GetDecimal())
+			}
+		})
+	}
+}
+
+func TestModSqrtComposite(t *testing.T) {
+	// Rabin decryption: the ciphertext is m^2 mod n for n = p*q with p ≡ q ≡ 3 (mod 4).
+	p, q := fromDecimal("1000000000000000000000000000099"), fromDecimal("1000000000000000000000000000211")
+	n := p.MUL(q)
+	m := fromDecimal("123456789012345678901234567890123456789")
+	c := m.MUL(m)
+	c = c.MOD(n)
+	factors := []bignumbers.PrimeFactor{{Prime: p, Exponent: 1}, {Prime: q, Exponent: 1}}
+	r, err := bignumbers.ModSqrtComposite(c, factors)
+	if err != nil {
+		t.Fatalf("ModSqrtComposite() error: %v", err)
+	}
+	square := r.MUL(r)
+	if square = square.MOD(n); !square.Equal(c) {
+		t.Errorf("ModSqrtComposite() error: %s is not a square root of %s", r.GetDecimal(), c.GetDecimal())
+	}
+
+	// 2^3 * 3^2 * 5: 49 is a square modulo every prime power.
+	factors = []bignumbers.PrimeFactor{{Prime: bignumbers.FromUint64(2), Exponent: 3}, {Prime: bignumbers.FromUint64(3), Exponent: 2}, {Prime: bignumbers.FromUint64(5), Exponent: 1}}
+	r, err = bignumbers.ModSqrtComposite(bignumbers.FromUint64(49), factors)
+	if err != nil {
+		t.Fatalf("ModSqrtComposite() error: %v", err)
+	}
+	square = r.MUL(r)
+	if square = square.MOD(bignumbers.FromUint64(360)); !square.Equal(bignumbers.FromUint64(49)) {
+		t.Errorf("ModSqrtComposite() error: %s is not a square root of 49 modulo 360", r.GetDecimal())
+	}
+
+	if _, err := bignumbers.ModSqrtComposite(bignumbers.FromUint64(3), factors); !errors.Is(err, bignumbers.ErrNoSolution) {
+		t.Errorf("ModSqrtComposite() expected ErrNoSolution but got %v", err)
+	}
+}
+
+func TestModCbrt(t *testing.T) {
+	tests := []struct {
+		name string
+		a, p string
+	}{
+		{name: "p = 2 mod 3", a: "3", p: "11"},
+		{name: "p = 3", a: "2", p: "3"},
+		{name: "p = 2", a: "1", p: "2"},
+		{name: "Zero", a: "0", p: "7"},
+		{name: "p = 1 mod 3", a: "6", p: "7"},
+		{name: "Adleman-Manders-Miller", a: "62078774762151738352", p: "100000000000000000441"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, p := fromDecimal(tt.a), fromDecimal(tt.p)
+			r, err := bignumbers.ModCbrt(a, p)
+			if err != nil {
+				t.Fatalf("ModCbrt() error: %v", err)
+			}
+			cube := r.MUL(r)
+			cube = cube.MUL(r)
+			if cube = cube.MOD(p); !cube.Equal(a.MOD(p)) {
+				t.Errorf("ModCbrt() error: %s^3 mod %s is %s", r.GetDecimal(), tt.p, cube.GetDecimal())
+			}
+		})
+	}
+	if _, err := bignumbers.ModCbrt(fromDecimal("2"), fromDecimal("7")); !errors.Is(err, bignumbers.ErrNoSolution) {
+		t.Errorf("ModCbrt() expected ErrNoSolution for a cubic non-residue but got %v", err)
+	}
+}
@@ -0,0 +1,140 @@
+package bignumbers_test
+
+import (
+	"errors"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestBigNumber_MUL(t *testing.T) {
+	tests := []struct {
+		name        string
+		left        string
+		right       string
+		expectedHex string
+	}{
+		{name: "MUL #1", left: "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4", right: "403db8ad88a3932a0b7e8189aed9eeffb8121dfac05c3512fdb396dd73f6331c", expectedHex: "14838db3fbf95254ed7fac950c07d63870966ca0c3d64f74cf0e0e9d4e5b806db2ea7c7e4a90f5f94389230c648dcc6e8e0ed99c1b5f5680be402415154845f0"},
+		{name: "MUL #2", left: "ffffffffffffffff", right: "ffffffffffffffff", expectedHex: "fffffffffffffffe0000000000000001"},
+		{name: "MUL #3", left: "123", right: "", expectedHex: ""},
+		{name: "MUL #4", left: "10000000000000000", right: "10000000000000000", expectedHex: "100000000000000000000000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var left, right bignumbers.BigNumber
+			left.SetHex(tt.left)
+			right.SetHex(tt.right)
+			if product := left.MUL(right); product.GetHex() != tt.expectedHex {
+				t.Errorf("BigNumber.MUL() error: expected %s but got %s", tt.expectedHex, product.GetHex())
+			}
+		})
+	}
+}
+
+func TestBigNumber_DivMod(t *testing.T) {
+	tests := []struct {
+		name              string
+		left              string
+		right             string
+		expectedQuotient  string
+		expectedRemainder string
+	}{
+		{name: "DivMod #1", left: "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4", right: "403db8ad88a3932a0b7e8189aed9eeffb8121dfac05c3512fdb396dd73f6331c", expectedQuotient: "1", expectedRemainder: "1181a7d68c09c3fc98433d36ea1d8c1b9ced947dbf311d77770e41207250db88"},
+		{name: "DivMod #2", left: "fffffffffffffffe0000000000000001", right: "ffffffffffffffff", expectedQuotient: "ffffffffffffffff", expectedRemainder: ""},
+		{name: "DivMod #3", left: "5", right: "10000000000000000", expectedQuotient: "", expectedRemainder: "5"},
+		{name: "DivMod #4", left: "100000000000000000000000000000000", right: "ffffffffffffffffffffffffffffffff", expectedQuotient: "1", expectedRemainder: "1"},
+		{name: "DivMod #5", left: "7fffffffffffffff8000000000000000000000000000000000", right: "800000000000000000000000000000001", expectedQuotient: "fffffffffffffffef", expectedRemainder: "7fffffffffffffff00000000000000011"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var left, right bignumbers.BigNumber
+			left.SetHex(tt.left)
+			right.SetHex(tt.right)
+			quotient, remainder := left.DivMod(right)
+			if quotient.GetHex() != tt.expectedQuotient || remainder.GetHex() != tt.expectedRemainder {
+				t.Errorf("BigNumber.DivMod() error: expected %s, %s but got %s, %s", tt.expectedQuotient, tt.expectedRemainder, quotient.GetHex(), remainder.GetHex())
+			}
+			if div := left.DIV(right); div.GetHex() != tt.expectedQuotient {
+				t.Errorf("BigNumber.DIV() error: expected %s but got %s", tt.expectedQuotient, div.GetHex())
+			}
+		})
+	}
+}
+
+func TestBigNumber_DivModByZero(t *testing.T) {
+	defer func() {
+		if r := recover(); r != bignumbers.ErrDivisionByZero {
+			t.Errorf("BigNumber.DivMod() expected panic with ErrDivisionByZero but got %v", r)
+		}
+	}()
+	var bn bignumbers.BigNumber
+	bn.SetHex("ff")
+	bn.DivMod(bignumbers.BigNumber{})
+}
+
+func TestBigNumber_GCDModInverse(t *testing.T) {
+	tests := []struct {
+		name            string
+		left            string
+		right           string
+		expectedGCD     string
+		expectedInverse string
+	}{
+		{name: "Coprime", left: "1234567890abcdef", right: "7fffffffffffffffffffffffffffffff", expectedGCD: "1", expectedInverse: "5a203daa60336a16bc580f307b8cdfdc"},
+		{name: "Common factor", left: "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4", right: "403db8ad88a3932a0b7e8189aed9eeffb8121dfac05c3512fdb396dd73f6331c", expectedGCD: "4", expectedInverse: "-"},
+		{name: "Small", left: "3", right: "b", expectedGCD: "1", expectedInverse: "4"},
+		{name: "Larger than modulus", left: "e", right: "b", expectedGCD: "1", expectedInverse: "4"},
+		{name: "Zero", left: "", right: "b", expectedGCD: "b", expectedInverse: "-"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var left, right bignumbers.BigNumber
+			left.SetHex(tt.left)
+			right.SetHex(tt.right)
+			if gcd := left.GCD(right); gcd.GetHex() != tt.expectedGCD {
+				t.Errorf("BigNumber.GCD() error: expected %s but got %s", tt.expectedGCD, gcd.GetHex())
+			}
+			inverse, err := left.ModInverse(right)
+			if tt.expectedInverse == "-" {
+				if !errors.Is(err, bignumbers.ErrNotInvertible) {
+					t.Errorf("BigNumber.ModInverse() expected ErrNotInvertible but got %v", err)
+				}
+				return
+			}
+			if err != nil || inverse.GetHex() != tt.expectedInverse {
+				t.Errorf("BigNumber.ModInverse() error: expected %s but got %s (%v)", tt.expectedInverse, inverse.GetHex(), err)
+			}
+		})
+	}
+}
+
+func TestBigNumber_ModExp(t *testing.T) {
+	tests := []struct {
+		name        string
+		base        string
+		exponent    string
+		modulus     string
+		expectedHex string
+	}{
+		{name: "ModExp #1", base: "51bf608414ad5726a3c1bec098f77b1b", exponent: "403db8ad88a3932a0b7e8189aed9eeff", modulus: "7fffffffffffffffffffffffffffffff", expectedHex: "2e82aedf4a10261bd00bfcd2b5a08dd0"},
+		{name: "ModExp #2", base: "3", exponent: "3e8", modulus: "1fffffffffffffff", expectedHex: "11289b7f33188a4b"},
+		{name: "Zero exponent", base: "1234", exponent: "", modulus: "7", expectedHex: "1"},
+		{name: "Modulus one", base: "1234", exponent: "", modulus: "1", expectedHex: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var base, exponent, modulus bignumbers.BigNumber
+			base.SetHex(tt.base)
+			exponent.SetHex(tt.exponent)
+			modulus.SetHex(tt.modulus)
+			if result := base.ModExp(exponent, modulus); result.GetHex() != tt.expectedHex {
+				t.Errorf("BigNumber.ModExp() error: expected %s but got %s", tt.expectedHex, result.GetHex())
+			}
+			if exp, ok := exponent.Uint64(); ok {
+				if result := base.POWMOD(modulus, exp); result.GetHex() != tt.expectedHex {
+					t.Errorf("BigNumber.POWMOD() error: expected %s but got %s", tt.expectedHex, result.GetHex())
+				}
+			}
+		})
+	}
+}
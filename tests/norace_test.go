@@ -0,0 +1,5 @@
+//go:build !race
+
+package bignumbers_test
+
+const raceEnabled = false
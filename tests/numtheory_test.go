@@ -0,0 +1,235 @@
+package bignumbers_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// fromDecimal parses a decimal string in tests.
+func fromDecimal(s string) bignumbers.BigNumber {
+	var bn bignumbers.BigNumber
+	bn.SetDecimal(s)
+	return bn
+}
+
+func TestJacobi(t *testing.T) {
+	tests := []struct {
+		a, n     string
+		expected int
+	}{
+		{a: "1001", n: "9907", expected: -1},
+		{a: "19", n: "45", expected: 1},
+		{a: "8", n: "21", expected: -1},
+		{a: "5", n: "21", expected: 1},
+		{a: "6", n: "21", expected: 0},
+		{a: "0", n: "1", expected: 1},
+		{a: "12345678901234567890", n: "1000000000000000000000000000057", expected: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.n, func(t *testing.T) {
+			got, err := bignumbers.Jacobi(fromDecimal(tt.a), fromDecimal(tt.n))
+			if err != nil {
+				t.Fatalf("Jacobi() error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Jacobi() error: expected %d but got %d", tt.expected, got)
+			}
+		})
+	}
+	for _, n := range []string{"0", "10"} {
+		if _, err := bignumbers.Jacobi(fromDecimal("3"), fromDecimal(n)); !errors.Is(err, bignumbers.ErrDomain) {
+			t.Errorf("Jacobi() expected ErrDomain for n = %s but got %v", n, err)
+		}
+	}
+}
+
+func TestKronecker(t *testing.T) {
+	tests := []struct {
+		a, n     string
+		expected int
+	}{
+		{a: "3", n: "8", expected: -1},
+		{a: "3", n: "4", expected: 1},
+		{a: "5", n: "6", expected: 1},
+		{a: "7", n: "0", expected: 0},
+		{a: "1", n: "0", expected: 1},
+		{a: "6", n: "4", expected: 0},
+		{a: "7", n: "16", expected: 1},
+		{a: "3", n: "2", expected: -1},
+		{a: "11", n: "30", expected: 1},
+		{a: "1001", n: "9907", expected: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.n, func(t *testing.T) {
+			if got := bignumbers.Kronecker(fromDecimal(tt.a), fromDecimal(tt.n)); got != tt.expected {
+				t.Errorf("Kronecker() error: expected %d but got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEulerPhiAndCarmichaelLambda(t *testing.T) {
+	tests := []struct {
+		n      string
+		phi    string
+		lambda string
+	}{
+		{n: "1", phi: "1", lambda: "1"},
+		{n: "2", phi: "1", lambda: "1"},
+		{n: "8", phi: "4", lambda: "2"},
+		{n: "16", phi: "8", lambda: "4"},
+		{n: "36", phi: "12", lambda: "6"},
+		{n: "561", phi: "320", lambda: "80"},
+		{n: "1000000", phi: "400000", lambda: "50000"},
+		{n: "580608", phi: "165888", lambda: "6912"},
+		{n: "1292009611902938227341860760114639667443", phi: "861339741268625484521027272583808024900", lambda: "47852207848256971362279292921322668050"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.n, func(t *testing.T) {
+			phi, err := bignumbers.EulerPhi(context.Background(), fromDecimal(tt.n))
+			if err != nil {
+				t.Fatalf("EulerPhi() error: %v", err)
+			}
+			if got := phi.GetDecimal(); got != tt.phi {
+				t.Errorf("EulerPhi() error: expected %s but got %s", tt.phi, got)
+			}
+			lambda, err := bignumbers.CarmichaelLambda(context.Background(), fromDecimal(tt.n))
+			if err != nil {
+				t.Fatalf("CarmichaelLambda() error: %v", err)
+			}
+			if got := lambda.GetDecimal(); got != tt.lambda {
+				t.Errorf("CarmichaelLambda() error: expected %s but got %s", tt.lambda, got)
+			}
+		})
+	}
+}
+
+func TestMobius(t *testing.T) {
+	tests := []struct {
+		n        string
+		expected int
+	}{
+		{n: "1", expected: 1},
+		{n: "2", expected: -1},
+		{n: "30", expected: -1},
+		{n: "210", expected: 1},
+		{n: "12", expected: 0},
+		{n: "1000000014000000049", expected: 0},
+		{n: "8957021371616587437343509934959791256563", expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.n, func(t *testing.T) {
+			got, err := bignumbers.Mobius(context.Background(), fromDecimal(tt.n))
+			if err != nil {
+				t.Fatalf("Mobius() error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Mobius() error: expected %d but got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDivisorsAndSigmaK(t *testing.T) {
+	tests := []struct {
+		n        string
+		divisors string
+		k        uint64
+		sigma    string
+	}{
+		{n: "1", divisors: "1", k: 3, sigma: "1"},
+		{n: "12", divisors: "1 2 3 4 6 12", k: 0, sigma: "6"},
+		{n: "12", divisors: "1 2 3 4 6 12", k: 1, sigma: "28"},
+		{n: "12", divisors: "1 2 3 4 6 12", k: 2, sigma: "210"},
+		{n: "97", divisors: "1 97", k: 5, sigma: "8587340258"},
+		{n: "360", divisors: "1 2 3 4 5 6 8 9 10 12 15 18 20 24 30 36 40 45 60 72 90 120 180 360", k: 1, sigma: "1170"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.n, func(t *testing.T) {
+			divisors, err := bignumbers.Divisors(context.Background(), fromDecimal(tt.n))
+			if err != nil {
+				t.Fatalf("Divisors() error: %v", err)
+			}
+			parts := make([]string, len(divisors))
+			for i := range divisors {
+				parts[i] = divisors[i].GetDecimal()
+			}
+			if got := strings.Join(parts, " "); got != tt.divisors {
+				t.Errorf("Divisors() error: expected %s but got %s", tt.divisors, got)
+			}
+			sigma, err := bignumbers.SigmaK(context.Background(), fromDecimal(tt.n), tt.k)
+			if err != nil {
+				t.Fatalf("SigmaK() error: %v", err)
+			}
+			if got := sigma.GetDecimal(); got != tt.sigma {
+				t.Errorf("SigmaK() error: expected %s but got %s", tt.sigma, got)
+			}
+		})
+	}
+}
+
+func TestMultiplicativeOrder(t *testing.T) {
+	tests := []struct {
+		a, n     string
+		expected string
+	}{
+		{a: "2", n: "7", expected: "3"},
+		{a: "10", n: "49", expected: "42"},
+		{a: "2", n: "561", expected: "40"},
+		{a: "5", n: "1", expected: "1"},
+		{a: "2", n: "1000000007", expected: "500000003"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" mod "+tt.n, func(t *testing.T) {
+			order, err := bignumbers.MultiplicativeOrder(context.Background(), fromDecimal(tt.a), fromDecimal(tt.n))
+			if err != nil {
+				t.Fatalf("MultiplicativeOrder() error: %v", err)
+			}
+			if got := order.GetDecimal(); got != tt.expected {
+				t.Errorf("MultiplicativeOrder() error: expected %s but got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNumberTheory_Errors(t *testing.T) {
+	ctx := context.Background()
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	zero := bignumbers.BigNumber{}
+	// A 60-digit semiprime that trial division cannot factor.
+	semiprime := fromDecimal("121932631137021795226185032752758725782151044046102621551703")
+	tests := []struct {
+		name     string
+		call     func() error
+		expected error
+	}{
+		{name: "EulerPhi", call: func() error { _, err := bignumbers.EulerPhi(ctx, zero); return err }, expected: bignumbers.ErrDomain},
+		{name: "CarmichaelLambda", call: func() error { _, err := bignumbers.CarmichaelLambda(ctx, zero); return err }, expected: bignumbers.ErrDomain},
+		{name: "Mobius", call: func() error { _, err := bignumbers.Mobius(ctx, zero); return err }, expected: bignumbers.ErrDomain},
+		{name: "Divisors", call: func() error { _, err := bignumbers.Divisors(ctx, zero); return err }, expected: bignumbers.ErrDomain},
+		{name: "SigmaK", call: func() error { _, err := bignumbers.SigmaK(ctx, zero, 1); return err }, expected: bignumbers.ErrDomain},
+		{name: "MultiplicativeOrder modulo zero", call: func() error { _, err := bignumbers.MultiplicativeOrder(ctx, fromDecimal("3"), zero); return err }, expected: bignumbers.ErrDomain},
+		{name: "EulerPhi cancelled", call: func() error { _, err := bignumbers.EulerPhi(cancelled, semiprime); return err }, expected: context.Canceled},
+		{name: "Divisors cancelled", call: func() error { _, err := bignumbers.Divisors(cancelled, semiprime); return err }, expected: context.Canceled},
+		{name: "MultiplicativeOrder cancelled", call: func() error {
+			_, err := bignumbers.MultiplicativeOrder(cancelled, fromDecimal("2"), semiprime)
+			return err
+		}, expected: context.Canceled},
+		{name: "MultiplicativeOrder not coprime", call: func() error {
+			_, err := bignumbers.MultiplicativeOrder(ctx, fromDecimal("6"), fromDecimal("9"))
+			return err
+		}, expected: bignumbers.ErrNotInvertible},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, tt.expected) {
+				t.Errorf("expected %v but got %v", tt.expected, err)
+			}
+		})
+	}
+}
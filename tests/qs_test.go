@@ -0,0 +1,87 @@
+package bignumbers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestQuadraticSieve(t *testing.T) {
+	tests := []struct {
+		name    string
+		decimal string
+		factors []string
+	}{
+		{name: "Even", decimal: "1000000000000000000000000000002", factors: []string{"2"}},
+		{name: "Factor base prime", decimal: "10403", factors: []string{"101", "103"}},
+		{name: "Perfect square", decimal: "100000000000000000000000260000000000000000000000169", factors: []string{"10000000000000000000000013"}},
+		{name: "29 digits", decimal: "30000000004401200000084559937", factors: []string{"100000000012397", "300000000006821"}},
+		{name: "40 digits", decimal: "8957021371616587437343509934959791256563", factors: []string{"90532746179102435393", "98936812917358800691"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n bignumbers.BigNumber
+			n.SetDecimal(tt.decimal)
+			d, err := bignumbers.QuadraticSieve(context.Background(), n)
+			if err != nil {
+				t.Fatalf("QuadraticSieve() error: %v", err)
+			}
+			for _, f := range tt.factors {
+				if d.GetDecimal() == f {
+					return
+				}
+			}
+			t.Errorf("QuadraticSieve() error: expected one of %v but got %s", tt.factors, d.GetDecimal())
+		})
+	}
+}
+
+func TestQuadraticSieve_Large(t *testing.T) {
+	if testing.Short() || raceEnabled {
+		// CI runs these in a separate step without the race detector.
+		t.Skip("sieving 50 and 60-digit numbers takes several seconds")
+	}
+	tests := []struct {
+		name    string
+		decimal string
+		p, q    string
+	}{
+		{name: "50 digits", decimal: "10818217596906270915994376538390741922823107521139", p: "1831229426378333448730183", q: "5907625467935888552559733"},
+		{name: "60 digits", decimal: "121932631137021795226185032752758725782151044046102621551703", p: "123456789012345678901234567907", q: "987654321098765432109876543229"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n bignumbers.BigNumber
+			n.SetDecimal(tt.decimal)
+			d, err := bignumbers.QuadraticSieve(context.Background(), n)
+			if err != nil {
+				t.Fatalf("QuadraticSieve() error: %v", err)
+			}
+			if got := d.GetDecimal(); got != tt.p && got != tt.q {
+				t.Errorf("QuadraticSieve() error: expected %s or %s but got %s", tt.p, tt.q, got)
+			}
+		})
+	}
+}
+
+func TestQuadraticSieve_NotComposite(t *testing.T) {
+	for _, decimal := range []string{"0", "1", "3", "909090909090909090909090909091"} {
+		var n bignumbers.BigNumber
+		n.SetDecimal(decimal)
+		if _, err := bignumbers.QuadraticSieve(context.Background(), n); err == nil {
+			t.Errorf("QuadraticSieve() expected an error for %s", decimal)
+		}
+	}
+}
+
+func TestQuadraticSieve_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var n bignumbers.BigNumber
+	n.SetDecimal("8957021371616587437343509934959791256563")
+	if _, err := bignumbers.QuadraticSieve(ctx, n); !errors.Is(err, context.Canceled) {
+		t.Errorf("QuadraticSieve() expected context.Canceled but got %v", err)
+	}
+}
@@ -0,0 +1,7 @@
+//go:build race
+
+package bignumbers_test
+
+// raceEnabled reports whether the tests run under the race detector, which makes the
+// long-running factoring tests too slow.
+const raceEnabled = true
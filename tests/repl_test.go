@@ -0,0 +1,65 @@
+package bignumbers_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/danielost/big-numbers/src/cli"
+)
+
+func runRepl(t *testing.T, historyFile, input string) string {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	if code := cli.Run([]string{"repl", "--history", historyFile}, strings.NewReader(input), &stdout, &stderr); code != 0 {
+		t.Fatalf("cli.Run() exit code: expected 0 but got %d (stderr: %s)", code, stderr.String())
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("cli.Run() unexpected stderr: %s", stderr.String())
+	}
+	return strings.ReplaceAll(stdout.String(), "bn> ", "")
+}
+
+func TestRepl_Session(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOutput string
+	}{
+		{name: "Expression", input: "0xff + 1\n", expectedOutput: "hex 0x100  dec 256\n\n"},
+		{name: "Variables", input: "a = 0x51bf\nb = a << 64\nb | 1\n", expectedOutput: "a = hex 0x51bf  dec 20927\nb = hex 0x51bf0000000000000000  dec 386035013230519786668032\nhex 0x51bf0000000000000001  dec 386035013230519786668033\n\n"},
+		{name: "Last result", input: "5\n_ + 1\n", expectedOutput: "hex 0x5  dec 5\nhex 0x6  dec 6\n\n"},
+		{name: "Bases", input: ":base bin oct hex\n:base\n0xff\n", expectedOutput: "bases: bin oct hex\nbin 0b11111111  oct 0o377  hex 0xff\n\n"},
+		{name: "Bits", input: ":bits 0x1ff\n", expectedOutput: "9 bits\n\n"},
+		{name: "Blocks", input: "x = 0x10000000000000002\n:blocks x\n", expectedOutput: "x = hex 0x10000000000000002  dec 18446744073709551618\n2 blocks\n[0] 0x0000000000000002  2\n[1] 0x0000000000000001  1\n\n"},
+		{name: "Vars", input: "b = 2\na = 1\n:base dec\n:vars\n", expectedOutput: "b = hex 0x2  dec 2\na = hex 0x1  dec 1\na = dec 1\nb = dec 2\n\n"},
+		{name: "Errors", input: "c + 1\n1 = 2\n:base b64\n:nope\n", expectedOutput: "error: col 1: undefined variable \"c\"\nerror: invalid variable name \"1\"\nerror: unknown base \"b64\", expected one of hex, dec, bin, oct\nerror: unknown command \":nope\", type :help for the list of commands\n\n"},
+		{name: "Quit", input: "1\n:quit\n2\n", expectedOutput: "hex 0x1  dec 1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if output := runRepl(t, "", tt.input); output != tt.expectedOutput {
+				t.Errorf("repl output: expected %q but got %q", tt.expectedOutput, output)
+			}
+		})
+	}
+}
+
+func TestRepl_History(t *testing.T) {
+	historyFile := filepath.Join(t.TempDir(), "history")
+	runRepl(t, historyFile, "a = 1\n\na + 1\n")
+	output := runRepl(t, historyFile, ":history\n")
+	expected := "    1  a = 1\n    2  a + 1\n    3  :history\n\n"
+	if output != expected {
+		t.Errorf("repl history: expected %q but got %q", expected, output)
+	}
+	data, err := os.ReadFile(historyFile)
+	if err != nil {
+		t.Fatalf("os.ReadFile() error: %v", err)
+	}
+	if string(data) != "a = 1\na + 1\n:history\n" {
+		t.Errorf("history file: expected %q but got %q", "a = 1\na + 1\n:history\n", string(data))
+	}
+}
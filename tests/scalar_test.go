@@ -0,0 +1,67 @@
+package bignumbers_test
+
+import (
+	"errors"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+func TestBigNumber_Uint64Operations(t *testing.T) {
+	tests := []struct {
+		name              string
+		hex               string
+		x                 uint64
+		expectedSum       string
+		expectedDiff      string
+		expectedProduct   string
+		expectedQuotient  string
+		expectedRemainder uint64
+		expectedCmp       int
+	}{
+		{name: "Zero and one", hex: "", x: 1, expectedSum: "1", expectedDiff: "-", expectedProduct: "", expectedQuotient: "", expectedRemainder: 0, expectedCmp: -1},
+		{name: "Equal", hex: "2a", x: 42, expectedSum: "54", expectedDiff: "", expectedProduct: "6e4", expectedQuotient: "1", expectedRemainder: 0, expectedCmp: 0},
+		{name: "Carry across blocks", hex: "ffffffffffffffffffffffffffffffff", x: 1, expectedSum: "100000000000000000000000000000000", expectedDiff: "fffffffffffffffffffffffffffffffe", expectedProduct: "ffffffffffffffffffffffffffffffff", expectedQuotient: "ffffffffffffffffffffffffffffffff", expectedRemainder: 0, expectedCmp: 1},
+		{name: "Borrow across blocks", hex: "100000000000000000000000000000000", x: 2, expectedSum: "100000000000000000000000000000002", expectedDiff: "fffffffffffffffffffffffffffffffe", expectedProduct: "200000000000000000000000000000000", expectedQuotient: "80000000000000000000000000000000", expectedRemainder: 0, expectedCmp: 1},
+		{name: "Max uint64", hex: "51bf608414ad5726a3c1bec098f77b1b", x: 0xffffffffffffffff, expectedSum: "51bf608414ad5727a3c1bec098f77b1a", expectedDiff: "51bf608414ad5725a3c1bec098f77b1c", expectedProduct: "51bf608414ad572652025e3c844a23f45c3e413f670884e5", expectedQuotient: "51bf608414ad5726", expectedRemainder: 0xf5811f44ada4d241, expectedCmp: 1},
+		{name: "Smaller than x", hex: "ff", x: 0x100, expectedSum: "1ff", expectedDiff: "-", expectedProduct: "ff00", expectedQuotient: "", expectedRemainder: 0xff, expectedCmp: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			if sum := bn.AddUint64(tt.x); sum.GetHex() != tt.expectedSum {
+				t.Errorf("BigNumber.AddUint64() error: expected %s but got %s", tt.expectedSum, sum.GetHex())
+			}
+			diff, err := bn.SubUint64(tt.x)
+			if tt.expectedDiff == "-" {
+				if !errors.Is(err, bignumbers.ErrNegativeResult) {
+					t.Errorf("BigNumber.SubUint64() expected ErrNegativeResult but got %v", err)
+				}
+			} else if err != nil || diff.GetHex() != tt.expectedDiff {
+				t.Errorf("BigNumber.SubUint64() error: expected %s but got %s (%v)", tt.expectedDiff, diff.GetHex(), err)
+			}
+			if product := bn.MulUint64(tt.x); product.GetHex() != tt.expectedProduct {
+				t.Errorf("BigNumber.MulUint64() error: expected %s but got %s", tt.expectedProduct, product.GetHex())
+			}
+			quotient, remainder := bn.DivModUint64(tt.x)
+			if quotient.GetHex() != tt.expectedQuotient || remainder != tt.expectedRemainder {
+				t.Errorf("BigNumber.DivModUint64() error: expected %s, %x but got %s, %x", tt.expectedQuotient, tt.expectedRemainder, quotient.GetHex(), remainder)
+			}
+			if cmp := bn.CmpUint64(tt.x); cmp != tt.expectedCmp {
+				t.Errorf("BigNumber.CmpUint64() error: expected %d but got %d", tt.expectedCmp, cmp)
+			}
+		})
+	}
+}
+
+func TestBigNumber_DivModUint64ByZero(t *testing.T) {
+	defer func() {
+		if r := recover(); r != bignumbers.ErrDivisionByZero {
+			t.Errorf("BigNumber.DivModUint64() expected panic with ErrDivisionByZero but got %v", r)
+		}
+	}()
+	var bn bignumbers.BigNumber
+	bn.SetHex("ff")
+	bn.DivModUint64(0)
+}
@@ -0,0 +1,118 @@
+package bignumbers_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// sievePrimes returns the primes of [lo, hi] joined with spaces and their count.
+func sievePrimes(lo, hi bignumbers.BigNumber) (string, int) {
+	var primes []string
+	for sieve := bignumbers.NewPrimeSieve(lo, hi); sieve.Next(); {
+		p := sieve.Prime()
+		primes = append(primes, p.GetDecimal())
+	}
+	return strings.Join(primes, " "), len(primes)
+}
+
+func TestPrimeSieve(t *testing.T) {
+	tests := []struct {
+		name     string
+		lo, hi   string
+		expected string
+	}{
+		{name: "From zero", lo: "0", hi: "50", expected: "2 3 5 7 11 13 17 19 23 29 31 37 41 43 47"},
+		{name: "Bounds are inclusive", lo: "7", hi: "13", expected: "7 11 13"},
+		{name: "Single prime", lo: "97", hi: "97", expected: "97"},
+		{name: "Prime gap", lo: "24", hi: "28", expected: ""},
+		{name: "Empty interval", lo: "10", hi: "5", expected: ""},
+		{
+			name:     "Around 2^32",
+			lo:       "4294967096",
+			hi:       "4294967496",
+			expected: "4294967111 4294967143 4294967161 4294967189 4294967197 4294967231 4294967279 4294967291 4294967311 4294967357 4294967371 4294967377 4294967387 4294967389 4294967459 4294967477",
+		},
+		{
+			name:     "Beyond the sieve range",
+			lo:       "1000000000000000000000000000000",
+			hi:       "1000000000000000000000000000300",
+			expected: "1000000000000000000000000000057 1000000000000000000000000000099 1000000000000000000000000000211 1000000000000000000000000000231 1000000000000000000000000000271",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := sievePrimes(fromDecimal(tt.lo), fromDecimal(tt.hi)); got != tt.expected {
+				t.Errorf("PrimeSieve error: expected %q but got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestPrimeSieve_Segments(t *testing.T) {
+	tests := []struct {
+		lo, hi   string
+		expected int
+	}{
+		{lo: "1", hi: "100000", expected: 9592},
+		{lo: "1000000000", hi: "1000100000", expected: 4832},
+	}
+	for _, tt := range tests {
+		if _, got := sievePrimes(fromDecimal(tt.lo), fromDecimal(tt.hi)); got != tt.expected {
+			t.Errorf("PrimeSieve error: expected %d primes in [%s, %s] but got %d", tt.expected, tt.lo, tt.hi, got)
+		}
+	}
+}
+
+func TestNextPrimeAndPrevPrime(t *testing.T) {
+	tests := []struct {
+		n, next, prev string
+	}{
+		{n: "3", next: "5", prev: "2"},
+		{n: "4", next: "5", prev: "3"},
+		{n: "1000", next: "1009", prev: "997"},
+		{n: "4294967296", next: "4294967311", prev: "4294967291"},
+		{n: "1000000000000000000000000000000", next: "1000000000000000000000000000057", prev: "999999999999999999999999999989"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.n, func(t *testing.T) {
+			next := bignumbers.NextPrime(fromDecimal(tt.n))
+			if got := next.GetDecimal(); got != tt.next {
+				t.Errorf("NextPrime() error: expected %s but got %s", tt.next, got)
+			}
+			prev, err := bignumbers.PrevPrime(fromDecimal(tt.n))
+			if err != nil {
+				t.Fatalf("PrevPrime() error: %v", err)
+			}
+			if got := prev.GetDecimal(); got != tt.prev {
+				t.Errorf("PrevPrime() error: expected %s but got %s", tt.prev, got)
+			}
+		})
+	}
+	for _, n := range []string{"0", "1", "2"} {
+		next := bignumbers.NextPrime(fromDecimal(n))
+		if expected := map[string]string{"0": "2", "1": "2", "2": "3"}[n]; next.GetDecimal() != expected {
+			t.Errorf("NextPrime() error: expected %s after %s but got %s", expected, n, next.GetDecimal())
+		}
+		if _, err := bignumbers.PrevPrime(fromDecimal(n)); !errors.Is(err, bignumbers.ErrDomain) {
+			t.Errorf("PrevPrime() expected ErrDomain for %s but got %v", n, err)
+		}
+	}
+}
+
+func TestNextPrime_SafePrime(t *testing.T) {
+	// The first safe prime p = 2q + 1 above 10^20.
+	p := fromDecimal("100000000000000000000")
+	for {
+		p = bignumbers.NextPrime(p)
+		q, _ := p.DivModUint64(2)
+		if q.ProbablyPrime(20) {
+			break
+		}
+	}
+	if got := p.GetDecimal(); got != "100000000000000000763" {
+		t.Errorf("NextPrime() error: expected the safe prime 100000000000000000763 but got %s", got)
+	}
+}
@@ -0,0 +1,235 @@
+package bignumbers_test
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+// fakeDriver is an in-memory database/sql driver with a single one-column table.
+// "INSERT" appends its argument, "SELECT" returns every stored value and "RESET" clears the table.
+// Strings are returned as []byte, as PostgreSQL drivers do for NUMERIC columns.
+type fakeDriver struct {
+	mu   sync.Mutex
+	rows []driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+type fakeRows struct {
+	rows []driver.Value
+	pos  int
+}
+
+var testDriver = &fakeDriver{}
+
+func init() {
+	sql.Register("bignumbers-fake", testDriver)
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d: d}, nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+func (s *fakeStmt) Close() error { return nil }
+func (s *fakeStmt) NumInput() int {
+	if s.query == "INSERT" {
+		return 1
+	}
+	return 0
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	switch s.query {
+	case "INSERT":
+		s.d.rows = append(s.d.rows, args[0])
+	case "RESET":
+		s.d.rows = nil
+	default:
+		return nil, fmt.Errorf("unsupported statement %q", s.query)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	rows := make([]driver.Value, len(s.d.rows))
+	for i, value := range s.d.rows {
+		if str, ok := value.(string); ok {
+			value = []byte(str)
+		}
+		rows[i] = value
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	dest[0] = r.rows[r.pos]
+	r.pos++
+	return nil
+}
+
+func openFakeDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("bignumbers-fake", "")
+	if err != nil {
+		t.Fatalf("sql.Open() error: %v", err)
+	}
+	if _, err := db.Exec("RESET"); err != nil {
+		t.Fatalf("db.Exec() error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestBigNumber_Value(t *testing.T) {
+	tests := []struct {
+		name          string
+		hex           string
+		mode          bignumbers.SQLMode
+		expectedValue driver.Value
+	}{
+		{name: "Auto", hex: "ff", mode: bignumbers.SQLAuto, expectedValue: "255"},
+		{name: "Decimal", hex: "10000000000000000", mode: bignumbers.SQLDecimal, expectedValue: "18446744073709551616"},
+		{name: "Decimal zero", hex: "0", mode: bignumbers.SQLDecimal, expectedValue: "0"},
+		{name: "Hex", hex: "abcdef0123456789fedcba", mode: bignumbers.SQLHex, expectedValue: "0xabcdef0123456789fedcba"},
+		{name: "Bytes", hex: "1020304", mode: bignumbers.SQLBytes, expectedValue: []byte{0x01, 0x02, 0x03, 0x04}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			value, err := bn.SQL(tt.mode).Value()
+			if err != nil {
+				t.Fatalf("SQLValue.Value() error: %v", err)
+			}
+			if fmt.Sprint(value) != fmt.Sprint(tt.expectedValue) {
+				t.Errorf("SQLValue.Value() error: expected %v but got %v", tt.expectedValue, value)
+			}
+		})
+	}
+}
+
+func TestBigNumber_Scan(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         any
+		mode        bignumbers.SQLMode
+		expectedHex string
+		wantErr     bool
+	}{
+		{name: "Decimal string", src: "18446744073709551616", mode: bignumbers.SQLAuto, expectedHex: "10000000000000000"},
+		{name: "Decimal bytes", src: []byte("255"), mode: bignumbers.SQLDecimal, expectedHex: "ff"},
+		{name: "Prefixed hex", src: []byte("0xABCDEF"), mode: bignumbers.SQLAuto, expectedHex: "abcdef"},
+		{name: "Bare hex", src: "abcdef", mode: bignumbers.SQLHex, expectedHex: "abcdef"},
+		{name: "Raw bytes", src: []byte{0xde, 0xad, 0xbe, 0xef}, mode: bignumbers.SQLBytes, expectedHex: "deadbeef"},
+		{name: "Raw bytes in auto mode", src: []byte{0xde, 0xad, 0xbe, 0xef}, mode: bignumbers.SQLAuto, wantErr: true},
+		{name: "Negative numeric", src: []byte("-5"), mode: bignumbers.SQLAuto, wantErr: true},
+		{name: "Fractional numeric", src: []byte("12.50"), mode: bignumbers.SQLAuto, wantErr: true},
+		{name: "Int64", src: int64(4096), mode: bignumbers.SQLAuto, expectedHex: "1000"},
+		{name: "Negative int64", src: int64(-1), mode: bignumbers.SQLAuto, wantErr: true},
+		{name: "Invalid decimal", src: []byte{0xde, 0xad}, mode: bignumbers.SQLDecimal, wantErr: true},
+		{name: "Float", src: 1.5, mode: bignumbers.SQLAuto, wantErr: true},
+		{name: "NULL", src: nil, mode: bignumbers.SQLAuto, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			err := bn.SQL(tt.mode).Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SQLValue.Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && bn.GetHex() != tt.expectedHex {
+				t.Errorf("SQLValue.Scan() error: expected %s but got %s", tt.expectedHex, bn.GetHex())
+			}
+		})
+	}
+}
+
+func TestBigNumber_SQLRoundTrip(t *testing.T) {
+	modes := []bignumbers.SQLMode{bignumbers.SQLAuto, bignumbers.SQLDecimal, bignumbers.SQLHex, bignumbers.SQLBytes}
+	hexes := []string{"0", "1", "ffffffffffffffff", "51bf608414ad5726a3c1bec098f77b1b54ffb2787f8d528a74c1d7fde6470ea4"}
+	for _, mode := range modes {
+		t.Run(fmt.Sprintf("Mode %d", mode), func(t *testing.T) {
+			db := openFakeDB(t)
+			for _, hex := range hexes {
+				var bn bignumbers.BigNumber
+				bn.SetHex(hex)
+				if _, err := db.Exec("INSERT", bn.SQL(mode)); err != nil {
+					t.Fatalf("db.Exec() error: %v", err)
+				}
+			}
+			rows, err := db.Query("SELECT")
+			if err != nil {
+				t.Fatalf("db.Query() error: %v", err)
+			}
+			defer rows.Close()
+			var got []string
+			for rows.Next() {
+				var bn bignumbers.BigNumber
+				if err := rows.Scan(bn.SQL(mode)); err != nil {
+					t.Fatalf("rows.Scan() error: %v", err)
+				}
+				got = append(got, bn.GetHex())
+			}
+			expected := []string{"", "1", "ffffffffffffffff", hexes[3]}
+			if strings.Join(got, ",") != strings.Join(expected, ",") {
+				t.Errorf("SQL round trip error: expected %v but got %v", expected, got)
+			}
+		})
+	}
+
+	db := openFakeDB(t)
+	var bn bignumbers.BigNumber
+	bn.SetHex("abcdef0123456789fedcba9876543210")
+	if _, err := db.Exec("INSERT", bn); err != nil {
+		t.Fatalf("db.Exec() error: %v", err)
+	}
+	var scanned bignumbers.BigNumber
+	if err := db.QueryRow("SELECT").Scan(scanned.SQL(bignumbers.SQLAuto)); err != nil {
+		t.Fatalf("row.Scan() error: %v", err)
+	}
+	if !bytes.Equal(scanned.GetBytes(), bn.GetBytes()) {
+		t.Errorf("SQL round trip error: expected %s but got %s", bn.GetHex(), scanned.GetHex())
+	}
+}
+
+func TestBigNumber_ScanWithoutSQLValue(t *testing.T) {
+	db := openFakeDB(t)
+	if _, err := db.Exec("INSERT", bignumbers.FromUint64(42)); err != nil {
+		t.Fatalf("db.Exec() error: %v", err)
+	}
+	// BigNumber implements fmt.Scanner, not sql.Scanner, so columns must be scanned through SQLValue.
+	var bn bignumbers.BigNumber
+	if err := db.QueryRow("SELECT").Scan(&bn); err == nil {
+		t.Errorf("row.Scan(*BigNumber) expected an error but got %s", bn.GetDecimal())
+	}
+	if _, ok := any(&bn).(sql.Scanner); ok {
+		t.Errorf("*BigNumber unexpectedly implements sql.Scanner")
+	}
+}
@@ -2,6 +2,7 @@ package bignumbers_test
 
 import (
 	"math"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -54,6 +55,38 @@ func TestUint_SetHex(t *testing.T) {
 	}
 }
 
+func TestUint_SetHexSyntax(t *testing.T) {
+	tests := []struct {
+		name          string
+		hex           string
+		expectedValue uint64
+		wantErr       bool
+	}{
+		{name: "Prefix", hex: "0xFFFFFFFFFFFFFFFF", expectedValue: math.MaxUint64},
+		{name: "Upper-case prefix", hex: "0X1f", expectedValue: 31},
+		{name: "Separators", hex: "dead_beef_0000_0001", expectedValue: 0xdeadbeef00000001},
+		{name: "Separator after prefix", hex: "0x_ff", expectedValue: 255},
+		{name: "High digits", hex: "fedcba9876543211", expectedValue: 0xfedcba9876543211},
+		{name: "Empty", hex: "", expectedValue: 0},
+		{name: "Leading separator", hex: "_ff", wantErr: true},
+		{name: "Trailing separator", hex: "ff_", wantErr: true},
+		{name: "Double separator", hex: "f__f", wantErr: true},
+		{name: "Too long with prefix", hex: "0x1ffffffffffffffff", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u bignumbers.Uint
+			err := u.SetHex(tt.hex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Uint.SetHex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && u.GetDecimal() != tt.expectedValue {
+				t.Errorf("Uint.SetHex() error: expected %d but got %d", tt.expectedValue, u.GetDecimal())
+			}
+		})
+	}
+}
+
 func TestUint_GetBinary(t *testing.T) {
 	var bn1 bignumbers.Uint
 	var bn2 bignumbers.Uint
@@ -99,3 +132,169 @@ func TestUint_SetBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestUint_SetBinarySyntax(t *testing.T) {
+	tests := []struct {
+		name          string
+		binary        string
+		expectedValue uint64
+		wantErr       bool
+	}{
+		{name: "Prefix", binary: "0b1010", expectedValue: 10},
+		{name: "Separators", binary: "0B_1111_0000", expectedValue: 240},
+		{name: "Top bit", binary: "1000000000000000000000000000000000000000000000000000000000000001", expectedValue: 1<<63 + 1},
+		{name: "Trailing separator", binary: "10_", wantErr: true},
+		{name: "Too long with prefix", binary: "0b" + strings.Repeat("1", 65), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u bignumbers.Uint
+			err := u.SetBinary(tt.binary)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Uint.SetBinary() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && u.GetDecimal() != tt.expectedValue {
+				t.Errorf("Uint.SetBinary() error: expected %d but got %d", tt.expectedValue, u.GetDecimal())
+			}
+		})
+	}
+}
+
+func FuzzUint_SetHex(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(81985529216486895))
+	f.Add(uint64(math.MaxUint64))
+	f.Fuzz(func(t *testing.T, value uint64) {
+		var u, parsed bignumbers.Uint
+		u.SetDecimal(value)
+		if err := parsed.SetHex(u.GetHex()); err != nil {
+			t.Fatalf("Uint.SetHex(%q) error: %v", u.GetHex(), err)
+		}
+		if parsed.GetDecimal() != value {
+			t.Fatalf("Uint.SetHex(%q) error: expected %d but got %d", u.GetHex(), value, parsed.GetDecimal())
+		}
+		if expected := strconv.FormatUint(value, 16); value != 0 && u.GetHex() != expected {
+			t.Fatalf("Uint.GetHex() error: expected %s but got %s", expected, u.GetHex())
+		}
+	})
+}
+
+func FuzzUint_SetBinary(f *testing.F) {
+	f.Add(uint64(0))
+	f.Add(uint64(81985529216486895))
+	f.Add(uint64(math.MaxUint64))
+	f.Fuzz(func(t *testing.T, value uint64) {
+		var u, parsed bignumbers.Uint
+		u.SetDecimal(value)
+		if err := parsed.SetBinary(u.GetBinary()); err != nil {
+			t.Fatalf("Uint.SetBinary(%q) error: %v", u.GetBinary(), err)
+		}
+		if parsed.GetDecimal() != value {
+			t.Fatalf("Uint.SetBinary(%q) error: expected %d but got %d", u.GetBinary(), value, parsed.GetDecimal())
+		}
+		if expected := strconv.FormatUint(value, 2); value != 0 && u.GetBinary() != expected {
+			t.Fatalf("Uint.GetBinary() error: expected %s but got %s", expected, u.GetBinary())
+		}
+	})
+}
+
+func TestUint_AddCarrySubBorrow(t *testing.T) {
+	tests := []struct {
+		name           string
+		left           uint64
+		right          uint64
+		carry          uint64
+		expectedSum    uint64
+		expectedCarry  uint64
+		expectedDiff   uint64
+		expectedBorrow uint64
+	}{
+		{name: "No carry", left: 5, right: 3, carry: 0, expectedSum: 8, expectedCarry: 0, expectedDiff: 2, expectedBorrow: 0},
+		{name: "Carry in", left: 5, right: 3, carry: 1, expectedSum: 9, expectedCarry: 0, expectedDiff: 1, expectedBorrow: 0},
+		{name: "Overflow", left: math.MaxUint64, right: 1, carry: 0, expectedSum: 0, expectedCarry: 1, expectedDiff: math.MaxUint64 - 1, expectedBorrow: 0},
+		{name: "Overflow from carry in", left: math.MaxUint64, right: 0, carry: 1, expectedSum: 0, expectedCarry: 1, expectedDiff: math.MaxUint64 - 1, expectedBorrow: 0},
+		{name: "Both overflow", left: math.MaxUint64, right: math.MaxUint64, carry: 1, expectedSum: math.MaxUint64, expectedCarry: 1, expectedDiff: math.MaxUint64, expectedBorrow: 1},
+		{name: "Underflow", left: 0, right: 1, carry: 0, expectedSum: 1, expectedCarry: 0, expectedDiff: math.MaxUint64, expectedBorrow: 1},
+		{name: "Underflow from borrow in", left: 3, right: 3, carry: 1, expectedSum: 7, expectedCarry: 0, expectedDiff: math.MaxUint64, expectedBorrow: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left := bignumbers.Uint{Value: tt.left}
+			right := bignumbers.Uint{Value: tt.right}
+			if sum, carry := left.AddCarry(right, tt.carry); sum.GetDecimal() != tt.expectedSum || carry != tt.expectedCarry {
+				t.Errorf("Uint.AddCarry() error: expected %d, %d but got %d, %d", tt.expectedSum, tt.expectedCarry, sum.GetDecimal(), carry)
+			}
+			if diff, borrow := left.SubBorrow(right, tt.carry); diff.GetDecimal() != tt.expectedDiff || borrow != tt.expectedBorrow {
+				t.Errorf("Uint.SubBorrow() error: expected %d, %d but got %d, %d", tt.expectedDiff, tt.expectedBorrow, diff.GetDecimal(), borrow)
+			}
+		})
+	}
+}
+
+func TestUint_Mul128Div128(t *testing.T) {
+	tests := []struct {
+		name       string
+		left       uint64
+		right      uint64
+		expectedHi uint64
+		expectedLo uint64
+	}{
+		{name: "Small", left: 6, right: 7, expectedHi: 0, expectedLo: 42},
+		{name: "Spills into hi", left: 1 << 63, right: 4, expectedHi: 2, expectedLo: 0},
+		{name: "Max", left: math.MaxUint64, right: math.MaxUint64, expectedHi: math.MaxUint64 - 1, expectedLo: 1},
+		{name: "81985529216486895 * 1000000007", left: 81985529216486895, right: 1000000007, expectedHi: 4444444, expectedLo: 8772451625092986761},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left := bignumbers.Uint{Value: tt.left}
+			right := bignumbers.Uint{Value: tt.right}
+			hi, lo := left.Mul128(right)
+			if hi.GetDecimal() != tt.expectedHi || lo.GetDecimal() != tt.expectedLo {
+				t.Fatalf("Uint.Mul128() error: expected %d, %d but got %d, %d", tt.expectedHi, tt.expectedLo, hi.GetDecimal(), lo.GetDecimal())
+			}
+			divisor := bignumbers.Uint{Value: tt.right + 1}
+			if tt.right == math.MaxUint64 {
+				divisor = right
+			}
+			quo, rem := hi.Div128(lo, divisor)
+			checkHi, checkLo := quo.Mul128(divisor)
+			checkLo, carry := checkLo.AddCarry(rem, 0)
+			if checkHi.GetDecimal()+carry != hi.GetDecimal() || checkLo.GetDecimal() != lo.GetDecimal() || rem.GetDecimal() >= divisor.GetDecimal() {
+				t.Errorf("Uint.Div128() error: %d * %d + %d != %d:%d", quo.GetDecimal(), divisor.GetDecimal(), rem.GetDecimal(), hi.GetDecimal(), lo.GetDecimal())
+			}
+		})
+	}
+}
+
+func TestUint_Shifts(t *testing.T) {
+	tests := []struct {
+		name             string
+		value            uint64
+		n                uint
+		expectedLeft     uint64
+		expectedLeftOut  uint64
+		expectedRight    uint64
+		expectedRightOut uint64
+		expectedZeros    int
+	}{
+		{name: "By zero", value: 0xff, n: 0, expectedLeft: 0xff, expectedLeftOut: 0, expectedRight: 0xff, expectedRightOut: 0, expectedZeros: 56},
+		{name: "By four", value: 0xf00000000000000f, n: 4, expectedLeft: 0xf0, expectedLeftOut: 0xf, expectedRight: 0x0f00000000000000, expectedRightOut: 0xf000000000000000, expectedZeros: 0},
+		{name: "By 63", value: 3, n: 63, expectedLeft: 1 << 63, expectedLeftOut: 1, expectedRight: 0, expectedRightOut: 6, expectedZeros: 62},
+		{name: "By 64", value: 0x1234, n: 64, expectedLeft: 0, expectedLeftOut: 0x1234, expectedRight: 0, expectedRightOut: 0x1234, expectedZeros: 51},
+		{name: "Zero", value: 0, n: 10, expectedLeft: 0, expectedLeftOut: 0, expectedRight: 0, expectedRightOut: 0, expectedZeros: 64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := bignumbers.Uint{Value: tt.value}
+			if result, spill := u.ShiftL(tt.n); result.GetDecimal() != tt.expectedLeft || spill.GetDecimal() != tt.expectedLeftOut {
+				t.Errorf("Uint.ShiftL() error: expected %x, %x but got %x, %x", tt.expectedLeft, tt.expectedLeftOut, result.GetDecimal(), spill.GetDecimal())
+			}
+			if result, spill := u.ShiftR(tt.n); result.GetDecimal() != tt.expectedRight || spill.GetDecimal() != tt.expectedRightOut {
+				t.Errorf("Uint.ShiftR() error: expected %x, %x but got %x, %x", tt.expectedRight, tt.expectedRightOut, result.GetDecimal(), spill.GetDecimal())
+			}
+			if zeros := u.LeadingZeros(); zeros != tt.expectedZeros {
+				t.Errorf("Uint.LeadingZeros() error: expected %d but got %d", tt.expectedZeros, zeros)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ func TestValidateHex(t *testing.T) {
 		{name: "Validate 123456789aBcDeF", hex: "123456789aBcDeF", wantErr: false},
 		{name: "Validate abcdef12541abcdef2", hex: "abcdef12541abcdef2", wantErr: true},
 		{name: "Validate eF3X7", hex: "eF3X7", wantErr: true},
+		{name: "Validate 0xFFFF_FFFF_FFFF_FFFF", hex: "0xFFFF_FFFF_FFFF_FFFF", wantErr: false},
+		{name: "Validate 0x_", hex: "0x_", wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -36,6 +38,8 @@ func TestValidateBinary(t *testing.T) {
 		{name: "Validate 100100011010001010110011110001001101010111100110111101111", binary: "100100011010001010110011110001001101010111100110111101111", wantErr: false},
 		{name: "Validate 111111111110011111111111111111111111111111111101111111111111111010101010", binary: "111111111110011111111111111111111111111111111101111111111111111010101010", wantErr: true},
 		{name: "Validate 10101201", binary: "10101201", wantErr: true},
+		{name: "Validate 0b1010_1010", binary: "0b1010_1010", wantErr: false},
+		{name: "Validate 1010__1010", binary: "1010__1010", wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,129 @@
+package bignumbers_test
+
+import (
+	"math/big"
+	"math/bits"
+	"testing"
+
+	bignumbers "github.com/danielost/big-numbers/src"
+)
+
+var widthTestWidths = []int{0, 1, 3, 8, 32, 63, 64, 65, 100, 128, 160, 255, 256, 260}
+
+// bigTruncate returns value modulo 2^width.
+func bigTruncate(value *big.Int, width int) *big.Int {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(width)), big.NewInt(1))
+	return new(big.Int).And(value, mask)
+}
+
+func bigRotL(value *big.Int, n, width int) *big.Int {
+	value = bigTruncate(value, width)
+	if width == 0 {
+		return value
+	}
+	n %= width
+	high := bigTruncate(new(big.Int).Lsh(value, uint(n)), width)
+	low := new(big.Int).Rsh(value, uint(width-n))
+	return high.Or(high, low)
+}
+
+func TestBigNumber_InvertWidth(t *testing.T) {
+	tests := []struct {
+		name        string
+		hex         string
+		width       int
+		expectedHex string
+	}{
+		{name: "Partial top nibble", hex: "1", width: 3, expectedHex: "6"},
+		{name: "Wider than value", hex: "ff", width: 16, expectedHex: "ff00"},
+		{name: "Zero", hex: "0", width: 64, expectedHex: "ffffffffffffffff"},
+		{name: "Zero width", hex: "ff", width: 0, expectedHex: ""},
+		{name: "Value wider than width", hex: "1ff", width: 8, expectedHex: ""},
+		{name: "Multiple blocks", hex: "1abc0000000dddddddddddddd0000ffffffff003", width: 160, expectedHex: "e543fffffff22222222222222ffff00000000ffc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bn bignumbers.BigNumber
+			bn.SetHex(tt.hex)
+			if result := bn.InvertWidth(tt.width); result.GetHex() != tt.expectedHex {
+				t.Errorf("BigNumber.InvertWidth(%d) error: expected %s but got %s", tt.width, tt.expectedHex, result.GetHex())
+			}
+		})
+	}
+
+	for _, hex := range bitTestValues {
+		var bn bignumbers.BigNumber
+		bn.SetHex(hex)
+		for _, width := range widthTestWidths {
+			allOnes := bigTruncate(big.NewInt(-1), width)
+			expected := new(big.Int).Xor(bigTruncate(bigFromHex(hex), width), allOnes)
+			if result := bn.InvertWidth(width); result.GetHex() != hexOf(expected) {
+				t.Errorf("BigNumber.InvertWidth(%d) of %s error: expected %s but got %s", width, hex, hexOf(expected), result.GetHex())
+			}
+		}
+	}
+}
+
+func TestBigNumber_ShiftLWidth(t *testing.T) {
+	shifts := []int{0, 1, 4, 63, 64, 65, 130, 300}
+	for _, hex := range bitTestValues {
+		var bn bignumbers.BigNumber
+		bn.SetHex(hex)
+		for _, width := range widthTestWidths {
+			for _, n := range shifts {
+				expected := bigTruncate(new(big.Int).Lsh(bigFromHex(hex), uint(n)), width)
+				if result := bn.ShiftLWidth(n, width); result.GetHex() != hexOf(expected) {
+					t.Errorf("BigNumber.ShiftLWidth(%d, %d) of %s error: expected %s but got %s", n, width, hex, hexOf(expected), result.GetHex())
+				}
+			}
+		}
+	}
+}
+
+func TestBigNumber_Rot(t *testing.T) {
+	rotations := []int{0, 1, 7, 63, 64, 65, 200, 511}
+	for _, hex := range bitTestValues {
+		var bn bignumbers.BigNumber
+		bn.SetHex(hex)
+		for _, width := range widthTestWidths {
+			for _, n := range rotations {
+				expected := bigRotL(bigFromHex(hex), n, width)
+				if result := bn.RotL(n, width); result.GetHex() != hexOf(expected) {
+					t.Errorf("BigNumber.RotL(%d, %d) of %s error: expected %s but got %s", n, width, hex, hexOf(expected), result.GetHex())
+				}
+				rotated := bn.RotL(n, width)
+				back := rotated.RotR(n, width)
+				if width > 0 && back.GetHex() != hexOf(bigTruncate(bigFromHex(hex), width)) {
+					t.Errorf("BigNumber.RotR(%d, %d) did not undo RotL for %s: got %s", n, width, hex, back.GetHex())
+				}
+			}
+		}
+	}
+}
+
+func TestBigNumber_RotNative(t *testing.T) {
+	values := []uint32{0, 1, 0x80000000, 0xdeadbeef, 0x12345678}
+	for _, value := range values {
+		var bn bignumbers.BigNumber
+		bn.SetBlocks([]bignumbers.Uint{{Value: uint64(value)}})
+		for n := 0; n < 40; n++ {
+			left := bn.RotL(n, 32)
+			right := bn.RotR(n, 32)
+			expectedLeft := uint64(bits.RotateLeft32(value, n))
+			expectedRight := uint64(bits.RotateLeft32(value, -n))
+			if got := blockValue(left); got != expectedLeft {
+				t.Errorf("BigNumber.RotL(%d, 32) of %#x error: expected %#x but got %#x", n, value, expectedLeft, got)
+			}
+			if got := blockValue(right); got != expectedRight {
+				t.Errorf("BigNumber.RotR(%d, 32) of %#x error: expected %#x but got %#x", n, value, expectedRight, got)
+			}
+		}
+	}
+}
+
+func blockValue(bn bignumbers.BigNumber) uint64 {
+	if len(bn.GetBlocks()) == 0 {
+		return 0
+	}
+	return bn.GetBlocks()[0].GetDecimal()
+}